@@ -1,6 +1,7 @@
 package main
 
 import (
+	"container/heap"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -11,6 +12,9 @@ type LogEntry struct {
 	ContainerID   string
 	ContainerName string
 	Line          string
+	Stream        string     // streamStdout or streamStderr ("" for separators and lines without stream)
+	Fields        *logFields // Parsed JSON/logfmt fields (nil for plain text)
+	Level         logLevel   // Detected level (levelUnknown when none)
 	Timestamp     time.Time
 	IsSeparator   bool // True if this is a user-inserted separator line
 }
@@ -49,7 +53,7 @@ func NewBufferConsumer(containerIDs []string, maxLines int, callback func(LogEnt
 }
 
 // OnLogLine is called when a new log line arrives
-func (bc *BufferConsumer) OnLogLine(containerID, containerName, line string, timestamp time.Time) {
+func (bc *BufferConsumer) OnLogLine(containerID, containerName, line, stream string, timestamp time.Time) {
 	// Check if we track this container
 	if !bc.containerIDs[containerID] {
 		return
@@ -62,8 +66,11 @@ func (bc *BufferConsumer) OnLogLine(containerID, containerName, line string, tim
 		ContainerID:   containerID,
 		ContainerName: containerName,
 		Line:          line,
+		Stream:        stream,
+		Fields:        parseLogFields(line),
 		Timestamp:     timestamp,
 	}
+	entry.Level = detectLogLevel(line, entry.Fields)
 
 	// Write to circular buffer (no reallocation)
 	bc.buffer[bc.head] = entry
@@ -116,21 +123,7 @@ func (bc *BufferConsumer) GetBuffer() []LogEntry {
 	if bc.size == 0 {
 		return []LogEntry{}
 	}
-
-	// Allocate exactly the necessary size
-	result := make([]LogEntry, bc.size)
-
-	if bc.size < bc.maxLines {
-		// Buffer not full yet, copy from 0 to size
-		copy(result, bc.buffer[:bc.size])
-	} else {
-		// Buffer full, rebuild in chronological order
-		// Oldest entries start at head
-		copy(result, bc.buffer[bc.head:])
-		copy(result[bc.maxLines-bc.head:], bc.buffer[:bc.head])
-	}
-
-	return result
+	return bc.entriesLocked()
 }
 
 // Clear empties the buffer (circular buffer reset)
@@ -184,35 +177,149 @@ func (bc *BufferConsumer) InsertSeparator() {
 	}
 }
 
-// PreloadLogs pre-fills the buffer with existing logs
-// IMPORTANT: containerIDs parameter ensures logs are loaded in stable order
-func (bc *BufferConsumer) PreloadLogs(containerIDs []string, logsByContainer map[string][]string, containerNames map[string]string) {
+// PreloadEntries pre-fills the buffer with existing log entries (Docker timestamps)
+// The container tails are merged by time with the lines already in the buffer (the consumer
+// may be registered before preloading so that no line is lost); lines received both live and
+// in a tail are kept once.
+// IMPORTANT: containerIDs parameter ensures logs are merged in stable order (ties keep this order)
+func (bc *BufferConsumer) PreloadEntries(containerIDs []string, entriesByContainer map[string][]LogEntry, containerNames map[string]string) {
 	bc.bufferMu.Lock()
 	defer bc.bufferMu.Unlock()
 
-	// Add logs from each container to the buffer using circular buffer
 	// CRITICAL FIX: Iterate over containerIDs slice (stable order) instead of map (random order)
 	// This ensures logs appear in the same order each time logs view is entered
+	preloaded := make(map[logEntryKey]bool)
+	sources := make([][]LogEntry, 0, len(containerIDs)+1)
 	for _, containerID := range containerIDs {
-		lines, exists := logsByContainer[containerID]
+		entries, exists := entriesByContainer[containerID]
 		if !exists {
 			continue
 		}
 		containerName := containerNames[containerID]
-		for _, line := range lines {
-			entry := LogEntry{
-				ContainerID:   containerID,
-				ContainerName: containerName,
-				Line:          line,
-				Timestamp:     time.Now(),
+		source := make([]LogEntry, len(entries))
+		for i, entry := range entries {
+			entry.ContainerID = containerID
+			entry.ContainerName = containerName
+			if entry.Fields == nil {
+				entry.Fields = parseLogFields(entry.Line)
 			}
-
-			// Use same pattern as OnLogLine for circular buffer
-			bc.buffer[bc.head] = entry
-			bc.head = (bc.head + 1) % bc.maxLines
-			if bc.size < bc.maxLines {
-				bc.size++
+			if entry.Level == levelUnknown {
+				entry.Level = detectLogLevel(entry.Line, entry.Fields)
 			}
+			source[i] = entry
+			preloaded[entry.key()] = true
+		}
+		sources = append(sources, source)
+	}
+
+	// Lines received live while the tails were fetched: drop those already in a tail
+	live := []LogEntry{}
+	for _, entry := range bc.entriesLocked() {
+		if entry.IsSeparator || !preloaded[entry.key()] {
+			live = append(live, entry)
+		}
+	}
+	sources = append(sources, live)
+
+	// Rewrite the circular buffer with the newest maxLines entries
+	merged := mergeLogEntries(sources)
+	if len(merged) > bc.maxLines {
+		merged = merged[len(merged)-bc.maxLines:]
+	}
+	copy(bc.buffer, merged)
+	bc.size = len(merged)
+	bc.head = bc.size % bc.maxLines
+}
+
+// entriesLocked returns the buffer entries in chronological order (caller holds bufferMu)
+func (bc *BufferConsumer) entriesLocked() []LogEntry {
+	// Allocate exactly the necessary size
+	result := make([]LogEntry, bc.size)
+
+	if bc.size < bc.maxLines {
+		// Buffer not full yet, copy from 0 to size
+		copy(result, bc.buffer[:bc.size])
+	} else {
+		// Buffer full, rebuild in chronological order
+		// Oldest entries start at head
+		copy(result, bc.buffer[bc.head:])
+		copy(result[bc.maxLines-bc.head:], bc.buffer[:bc.head])
+	}
+
+	return result
+}
+
+// logEntryKey identifies a log line across the live stream and a tail fetch
+type logEntryKey struct {
+	containerID string
+	timestamp   int64
+	line        string
+}
+
+func (e LogEntry) key() logEntryKey {
+	return logEntryKey{containerID: e.ContainerID, timestamp: e.Timestamp.UnixNano(), line: e.Line}
+}
+
+// mergeLogEntries merges time-ordered sources into one time-ordered list (k-way merge)
+// On equal timestamps, entries of the earlier source come first.
+func mergeLogEntries(sources [][]LogEntry) []LogEntry {
+	total := 0
+	for _, source := range sources {
+		total += len(source)
+	}
+	merged := make([]LogEntry, 0, total)
+
+	h := &logMergeHeap{sources: sources}
+	for i, source := range sources {
+		if len(source) > 0 {
+			h.items = append(h.items, logMergeCursor{source: i})
 		}
 	}
+	heap.Init(h)
+
+	for h.Len() > 0 {
+		cursor := &h.items[0]
+		merged = append(merged, sources[cursor.source][cursor.index])
+		cursor.index++
+		if cursor.index == len(sources[cursor.source]) {
+			heap.Pop(h)
+		} else {
+			heap.Fix(h, 0)
+		}
+	}
+	return merged
+}
+
+// logMergeCursor is the read position in one source of mergeLogEntries
+type logMergeCursor struct {
+	source int
+	index  int
+}
+
+// logMergeHeap orders source cursors by the timestamp of their next entry
+type logMergeHeap struct {
+	sources [][]LogEntry
+	items   []logMergeCursor
+}
+
+func (h *logMergeHeap) Len() int { return len(h.items) }
+
+func (h *logMergeHeap) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	ta := h.sources[a.source][a.index].Timestamp
+	tb := h.sources[b.source][b.index].Timestamp
+	if ta.Equal(tb) {
+		return a.source < b.source
+	}
+	return ta.Before(tb)
+}
+
+func (h *logMergeHeap) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *logMergeHeap) Push(x any) { h.items = append(h.items, x.(logMergeCursor)) }
+
+func (h *logMergeHeap) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
 }
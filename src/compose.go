@@ -0,0 +1,194 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/docker/docker/api/types"
+)
+
+// Docker Compose labels used to group containers by project
+const (
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+)
+
+// listRow is one row of the container list: a container, or a compose project header in grouped mode
+type listRow struct {
+	header    bool
+	project   string            // Compose project ("" for standalone containers)
+	container types.Container   // Container of a container row
+	members   []types.Container // Header rows: all containers of the project (collapsed or not)
+}
+
+// composeProject returns the compose project of a container ("" when not started by compose)
+func composeProject(c types.Container) string {
+	return c.Labels[composeProjectLabel]
+}
+
+// buildListRows builds the container list rows
+// Without grouping there is one row per container, in order. In grouped mode, compose projects
+// are listed by name with a header followed by their containers (sorted by service, hidden when
+// the project is collapsed), then standalone containers.
+func (m *model) buildListRows(containers []types.Container) []listRow {
+	rows := make([]listRow, 0, len(containers))
+	if !m.groupByProject {
+		for _, c := range containers {
+			rows = append(rows, listRow{container: c})
+		}
+		return rows
+	}
+
+	groups := make(map[string][]types.Container)
+	projects := []string{}
+	standalone := []types.Container{}
+	for _, c := range containers {
+		project := composeProject(c)
+		if project == "" {
+			standalone = append(standalone, c)
+			continue
+		}
+		if _, ok := groups[project]; !ok {
+			projects = append(projects, project)
+		}
+		groups[project] = append(groups[project], c)
+	}
+	sort.Strings(projects)
+
+	for _, project := range projects {
+		members := groups[project]
+		sort.SliceStable(members, func(i, j int) bool {
+			return members[i].Labels[composeServiceLabel] < members[j].Labels[composeServiceLabel]
+		})
+		rows = append(rows, listRow{header: true, project: project, members: members})
+		if m.collapsedProjects[project] {
+			continue
+		}
+		for _, c := range members {
+			rows = append(rows, listRow{project: project, container: c})
+		}
+	}
+	for _, c := range standalone {
+		rows = append(rows, listRow{container: c})
+	}
+	return rows
+}
+
+// containerIDs returns the containers a row stands for (all project containers for a header)
+func (r listRow) containerIDs() []string {
+	if !r.header {
+		return []string{r.container.ID}
+	}
+	ids := make([]string, 0, len(r.members))
+	for _, c := range r.members {
+		ids = append(ids, c.ID)
+	}
+	return ids
+}
+
+// listRows returns the rows indexed by m.cursor
+// In grouped mode the active filter applies, like in renderList.
+func (m *model) listRows() []listRow {
+	// CRITICAL FIX: Copy m.containers under lock, build rows outside of it
+	m.containersMu.RLock()
+	containers := make([]types.Container, len(m.containers))
+	copy(containers, m.containers)
+	m.containersMu.RUnlock()
+
+	if m.groupByProject && m.filterActive != "" {
+		visible := []types.Container{}
+		for _, c := range containers {
+			if m.containerMatchesFilter(c) {
+				visible = append(visible, c)
+			}
+		}
+		containers = visible
+	}
+	return m.buildListRows(containers)
+}
+
+// listRowCount returns the number of rows the cursor can move over
+func (m *model) listRowCount() int {
+	return len(m.listRows())
+}
+
+// rowContainerIDs returns the container IDs of row i (nil when out of range)
+func (m *model) rowContainerIDs(i int) []string {
+	rows := m.listRows()
+	if i < 0 || i >= len(rows) {
+		return nil
+	}
+	return rows[i].containerIDs()
+}
+
+// rangeContainerIDs returns the container IDs of rows start..end (inclusive)
+func (m *model) rangeContainerIDs(start, end int) []string {
+	rows := m.listRows()
+	ids := []string{}
+	for i := max(0, start); i <= end && i < len(rows); i++ {
+		ids = append(ids, rows[i].containerIDs()...)
+	}
+	return ids
+}
+
+// toggleRowSelection toggles the selection of row i
+// Header rows select all the project containers, or deselect them when they are all selected.
+func (m *model) toggleRowSelection(i int) {
+	ids := m.rowContainerIDs(i)
+	if len(ids) == 0 {
+		return
+	}
+
+	// CRITICAL FIX: Protect concurrent map write
+	m.selectedMu.Lock()
+	defer m.selectedMu.Unlock()
+	allSelected := true
+	for _, id := range ids {
+		if !m.selected[id] {
+			allSelected = false
+			break
+		}
+	}
+	for _, id := range ids {
+		m.selected[id] = !allSelected
+	}
+}
+
+// toggleGroupByProject switches grouped mode on/off, keeping the cursor in range
+func (m *model) toggleGroupByProject() {
+	m.groupByProject = !m.groupByProject
+	m.shiftStart = -1
+	m.clampListCursor()
+}
+
+// setProjectCollapsed collapses or expands the project of the cursor row
+// The cursor moves to the project header so that the rows under it stay in place.
+func (m *model) setProjectCollapsed(collapsed bool) {
+	if !m.groupByProject {
+		return
+	}
+	rows := m.listRows()
+	if m.cursor < 0 || m.cursor >= len(rows) || rows[m.cursor].project == "" {
+		return
+	}
+	project := rows[m.cursor].project
+
+	if m.collapsedProjects == nil {
+		m.collapsedProjects = make(map[string]bool)
+	}
+	m.collapsedProjects[project] = collapsed
+	for i, row := range m.listRows() {
+		if row.header && row.project == project {
+			m.cursor = i
+			break
+		}
+	}
+	m.shiftStart = -1
+}
+
+// clampListCursor keeps the cursor within the list rows
+func (m *model) clampListCursor() {
+	count := m.listRowCount()
+	if m.cursor >= count {
+		m.cursor = max(0, count-1)
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+)
+
+// composeContainer builds a container started by compose
+func composeContainer(id, name, project, service, state string) types.Container {
+	c := types.Container{ID: id, Names: []string{"/" + name}, State: state}
+	if project != "" {
+		c.Labels = map[string]string{composeProjectLabel: project, composeServiceLabel: service}
+	}
+	return c
+}
+
+func sampleComposeModel() *model {
+	m := createTestModel()
+	m.width = 120
+	m.groupByProject = true
+	m.containers = []types.Container{
+		composeContainer("a1", "lonely", "", "", "running"),
+		composeContainer("s2", "shop-web-1", "shop", "web", "running"),
+		composeContainer("s1", "shop-db-1", "shop", "db", "exited"),
+		composeContainer("b1", "blog-app-1", "blog", "app", "running"),
+	}
+	return m
+}
+
+func TestBuildListRows_Grouped(t *testing.T) {
+	m := sampleComposeModel()
+	rows := m.listRows()
+
+	got := []string{}
+	for _, r := range rows {
+		if r.header {
+			got = append(got, "#"+r.project)
+		} else {
+			got = append(got, getContainerName(r.container))
+		}
+	}
+	want := "#blog,blog-app-1,#shop,shop-db-1,shop-web-1,lonely"
+	if strings.Join(got, ",") != want {
+		t.Errorf("rows = %v, want %s", got, want)
+	}
+
+	// Collapsed projects keep their header only
+	m.collapsedProjects = map[string]bool{"shop": true}
+	if count := m.listRowCount(); count != 4 {
+		t.Errorf("expected 4 rows with shop collapsed, got %d", count)
+	}
+	if ids := m.rowContainerIDs(2); strings.Join(ids, ",") != "s1,s2" {
+		t.Errorf("collapsed header should still stand for all containers, got %v", ids)
+	}
+
+	// Without grouping, rows are the containers in order
+	m.groupByProject = false
+	if count := m.listRowCount(); count != 4 || m.rowContainerIDs(0)[0] != "a1" {
+		t.Error("ungrouped rows should follow m.containers")
+	}
+}
+
+func TestGroupedHeaderSelection(t *testing.T) {
+	m := sampleComposeModel()
+	m.cursor = 2 // shop header
+
+	// No selection: actions apply to the whole project under the cursor
+	if ids := m.getSelectedIDs(); strings.Join(ids, ",") != "s1,s2" {
+		t.Errorf("getSelectedIDs on header = %v", ids)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if !m.selected["s1"] || !m.selected["s2"] || m.selected["b1"] {
+		t.Errorf("space on header should select the project, got %v", m.selected)
+	}
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if m.selected["s1"] || m.selected["s2"] {
+		t.Error("second space on header should deselect the project")
+	}
+
+	// Shift+Down from the blog header selects blog and the rows below
+	m.cursor = 0
+	m.shiftStart = -1
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyShiftDown})
+	if !m.selected["b1"] || len(m.selected) != 1 {
+		t.Errorf("shift+down selection = %v", m.selected)
+	}
+
+	// Shell and inspect need a single container
+	m.selected = map[string]bool{}
+	m.cursor = 2
+	if _, _, errMsg := m.inspectTarget(); errMsg == "" {
+		t.Error("inspect on a project header should be refused")
+	}
+}
+
+func TestGroupedCollapseKeys(t *testing.T) {
+	m := createTestModel()
+	m.containers = sampleComposeModel().containers
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}})
+	if !m.groupByProject {
+		t.Fatal("g should enable grouping")
+	}
+
+	m.cursor = 4 // shop-web-1
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyLeft})
+	if !m.collapsedProjects["shop"] || m.cursor != 2 {
+		t.Errorf("left should collapse shop and move to its header, got cursor %d", m.cursor)
+	}
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnd})
+	if m.cursor != 3 {
+		t.Errorf("end should stop on the last row, got %d", m.cursor)
+	}
+	m.cursor = 2
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRight})
+	if m.collapsedProjects["shop"] {
+		t.Error("right should expand the project")
+	}
+
+	// Double click on a header toggles it without changing the selection
+	m.handleGroupedClick(0)
+	m.handleGroupedClick(0)
+	if !m.collapsedProjects["blog"] || m.selected["b1"] {
+		t.Errorf("double click should collapse blog only, selected %v", m.selected)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'G'}})
+	if m.groupByProject {
+		t.Error("G should disable grouping")
+	}
+}
+
+func TestRenderList_Grouped(t *testing.T) {
+	m := sampleComposeModel()
+	m.cpuCurrent["s2"] = 10
+	m.cpuCurrent["b1"] = 5
+
+	output := m.View()
+	for _, want := range []string{"▼ shop", "1/2 running", "▼ blog", "1/1 running", "  shop-web-1", "Projects: 2"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("grouped list should contain %q", want)
+		}
+	}
+
+	m.collapsedProjects = map[string]bool{"shop": true}
+	output = m.View()
+	if !strings.Contains(output, "▶ shop") || strings.Contains(output, "shop-web-1") {
+		t.Error("collapsed project should hide its containers")
+	}
+}
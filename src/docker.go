@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -53,13 +52,8 @@ func (m *model) getSelectedIDs() []string {
 		}
 		m.containersMu.RUnlock()
 	} else {
-		// No selection: return current cursor container
-		// CRITICAL FIX: Protect concurrent slice read
-		m.containersMu.RLock()
-		if m.cursor >= 0 && m.cursor < len(m.containers) {
-			ids = append(ids, m.containers[m.cursor].ID)
-		}
-		m.containersMu.RUnlock()
+		// No selection: return current cursor container (or the whole project on a header row)
+		ids = append(ids, m.rowContainerIDs(m.cursor)...)
 	}
 	return ids
 }
@@ -77,7 +71,8 @@ func (m *model) performAction(action string) tea.Cmd {
 }
 
 // performActionAsync executes the actual Docker action in parallel goroutines
-func performActionAsync(dockerClient *client.Client, action string, ids []string, containers []types.Container) tea.Cmd {
+// reload loads the container list afterwards (see reloadContainers).
+func performActionAsync(dockerClient *client.Client, reload tea.Cmd, action string, ids []string, containers []types.Container) tea.Cmd {
 	return func() tea.Msg {
 		// CRITICAL FIX: Add timeout to prevent indefinite hang on Docker daemon issues
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
@@ -174,7 +169,7 @@ func performActionAsync(dockerClient *client.Client, action string, ids []string
 			if successCount > 0 {
 				// Partial success
 				return tea.Batch(
-					loadContainers(dockerClient),
+					reload,
 					func() tea.Msg {
 						return toastMsg{
 							message:         fmt.Sprintf("%s: %d succeeded, %d failed", action, successCount, len(errors)),
@@ -186,7 +181,7 @@ func performActionAsync(dockerClient *client.Client, action string, ids []string
 			} else {
 				// All failed
 				return tea.Batch(
-					loadContainers(dockerClient),
+					reload,
 					func() tea.Msg {
 						return toastMsg{
 							message:         fmt.Sprintf("%s failed: %s", action, errors[0]),
@@ -199,7 +194,7 @@ func performActionAsync(dockerClient *client.Client, action string, ids []string
 		} else {
 			// All succeeded
 			return tea.Batch(
-				loadContainers(dockerClient),
+				reload,
 				func() tea.Msg {
 					return toastMsg{
 						message:         fmt.Sprintf("%s: %d container(s) succeeded", action, successCount),
@@ -224,22 +219,21 @@ func loadContainers(cli *client.Client) tea.Cmd {
 			return errorMsg{err}
 		}
 		// Sort by name
-		sort.Slice(containers, func(i, j int) bool {
-			// CRITICAL FIX: Protect against empty Names slice (Docker edge case)
-			nameI := ""
-			if len(containers[i].Names) > 0 {
-				nameI = containers[i].Names[0]
-			}
-			nameJ := ""
-			if len(containers[j].Names) > 0 {
-				nameJ = containers[j].Names[0]
-			}
-			return nameI < nameJ
-		})
+		sortContainersByName(containers)
 		return containerListMsg(containers)
 	}
 }
 
+// statusRefreshTickMsg periodically refreshes daemon-computed status strings (uptime)
+// when container state changes are delivered by the EventWatcher
+type statusRefreshTickMsg time.Time
+
+func statusRefreshTickCmd() tea.Cmd {
+	return tea.Tick(time.Minute*1, func(t time.Time) tea.Msg {
+		return statusRefreshTickMsg(t)
+	})
+}
+
 func tickCmd() tea.Cmd {
 	return tea.Tick(time.Second*5, func(t time.Time) tea.Msg {
 		return tickMsg(t)
@@ -257,8 +251,10 @@ func cpuTickCmd() tea.Cmd {
 
 // CPU stats message
 type cpuStatsMsg struct {
-	stats    map[string]float64                  // Container ID -> CPU percentage
-	rawStats map[string]*container.StatsResponse // Raw stats for storing
+	stats          map[string]float64                  // Container ID -> CPU percentage
+	rawStats       map[string]*container.StatsResponse // Raw stats for storing
+	containerStats map[string]ContainerStats           // Container ID -> memory, I/O and PID stats
+	host           int                                 // Index of the polled host in model.hosts
 }
 
 // fetchCPUStats fetches CPU stats for running containers (in parallel)
@@ -269,6 +265,7 @@ func fetchCPUStats(cli *client.Client, containers []types.Container, prevStats m
 
 		cpuStats := make(map[string]float64)
 		rawStats := make(map[string]*container.StatsResponse)
+		containerStats := make(map[string]ContainerStats)
 
 		// Use channels for parallel fetching
 		type statsResult struct {
@@ -354,14 +351,19 @@ func fetchCPUStats(cli *client.Client, containers []types.Container, prevStats m
 				}
 				cpuStats[result.id] = cpuPercent
 
+				// Memory, network, block I/O and PIDs from the same response
+				resources := calculateContainerStats(result.stats, prevStats[result.id])
+				resources.CPUPercent = cpuPercent
+				containerStats[result.id] = resources
+
 			case <-ctx.Done():
 				// Context timeout - abandon remaining results to prevent hang
 				// Goroutines will complete and send to buffered channel (won't leak)
-				return cpuStatsMsg{stats: cpuStats, rawStats: rawStats}
+				return cpuStatsMsg{stats: cpuStats, rawStats: rawStats, containerStats: containerStats}
 			}
 		}
 
-		return cpuStatsMsg{stats: cpuStats, rawStats: rawStats}
+		return cpuStatsMsg{stats: cpuStats, rawStats: rawStats, containerStats: containerStats}
 	}
 }
 
@@ -412,3 +414,107 @@ func calculateCPUPercentWithHistory(current, previous *container.StatsResponse)
 
 	return result
 }
+
+// ContainerStats holds the resource usage of a container computed from one stats sample
+// Rates are computed against the previous sample (0 when there is none yet).
+type ContainerStats struct {
+	CPUPercent     float64 `json:"cpu_percent"`
+	MemUsage       uint64  `json:"memory_usage"`     // Bytes, page cache excluded
+	MemLimit       uint64  `json:"memory_limit"`     // Bytes
+	MemPercent     float64 `json:"memory_percent"`   // MemUsage / MemLimit
+	NetRxRate      float64 `json:"net_rx_rate"`      // Bytes per second, all interfaces
+	NetTxRate      float64 `json:"net_tx_rate"`      // Bytes per second, all interfaces
+	BlockReadRate  float64 `json:"block_read_rate"`  // Bytes per second
+	BlockWriteRate float64 `json:"block_write_rate"` // Bytes per second
+	PIDs           uint64  `json:"pids"`
+}
+
+// calculateContainerStats computes memory, network, block I/O and PID stats (CPU is set by the caller)
+func calculateContainerStats(current, previous *container.StatsResponse) ContainerStats {
+	var result ContainerStats
+	if current == nil {
+		return result
+	}
+
+	result.MemUsage = calculateMemoryUsage(&current.MemoryStats)
+	result.MemLimit = current.MemoryStats.Limit
+	if result.MemLimit > 0 {
+		result.MemPercent = float64(result.MemUsage) / float64(result.MemLimit) * 100.0
+	}
+
+	result.PIDs = current.PidsStats.Current
+	if result.PIDs == 0 {
+		result.PIDs = uint64(current.NumProcs) // Windows
+	}
+
+	// Rates need a previous sample and a positive interval between both reads
+	if previous == nil {
+		return result
+	}
+	elapsed := current.Read.Sub(previous.Read).Seconds()
+	if elapsed <= 0 {
+		return result
+	}
+
+	rxNow, txNow := networkTotals(current)
+	rxPrev, txPrev := networkTotals(previous)
+	result.NetRxRate = counterRate(rxNow, rxPrev, elapsed)
+	result.NetTxRate = counterRate(txNow, txPrev, elapsed)
+
+	readNow, writeNow := blockIOTotals(current)
+	readPrev, writePrev := blockIOTotals(previous)
+	result.BlockReadRate = counterRate(readNow, readPrev, elapsed)
+	result.BlockWriteRate = counterRate(writeNow, writePrev, elapsed)
+
+	return result
+}
+
+// calculateMemoryUsage returns memory usage without page cache, like `docker stats`
+func calculateMemoryUsage(mem *container.MemoryStats) uint64 {
+	if mem.Usage == 0 {
+		return mem.PrivateWorkingSet // Windows
+	}
+	// cgroup v1 exposes total_inactive_file, cgroup v2 inactive_file
+	if v, ok := mem.Stats["total_inactive_file"]; ok && v < mem.Usage {
+		return mem.Usage - v
+	}
+	if v, ok := mem.Stats["inactive_file"]; ok && v < mem.Usage {
+		return mem.Usage - v
+	}
+	return mem.Usage
+}
+
+// networkTotals sums received and transmitted bytes over all interfaces
+func networkTotals(stats *container.StatsResponse) (rx, tx uint64) {
+	for _, n := range stats.Networks {
+		rx += n.RxBytes
+		tx += n.TxBytes
+	}
+	return rx, tx
+}
+
+// blockIOTotals sums bytes read and written over all block devices
+func blockIOTotals(stats *container.StatsResponse) (read, write uint64) {
+	for _, entry := range stats.BlkioStats.IoServiceBytesRecursive {
+		switch strings.ToLower(entry.Op) {
+		case "read":
+			read += entry.Value
+		case "write":
+			write += entry.Value
+		}
+	}
+	if read == 0 && write == 0 {
+		// Windows
+		read, write = stats.StorageStats.ReadSizeBytes, stats.StorageStats.WriteSizeBytes
+	}
+	return read, write
+}
+
+// counterRate returns the per-second rate between two cumulative counters
+// A counter that went backwards (container restart) yields 0 instead of a huge value.
+func counterRate(current, previous uint64, seconds float64) float64 {
+	if current < previous || seconds <= 0 {
+		return 0
+	}
+	return float64(current-previous) / seconds
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/docker/docker/api/types/container"
 )
@@ -253,6 +254,85 @@ func TestCalculateCPUFormula(t *testing.T) {
 	}
 }
 
+// TestCalculateMemoryUsage tests page cache exclusion for cgroup v1 and v2
+func TestCalculateMemoryUsage(t *testing.T) {
+	tests := []struct {
+		name string
+		mem  container.MemoryStats
+		want uint64
+	}{
+		{"no cache stats", container.MemoryStats{Usage: 1000}, 1000},
+		{"cgroup v1", container.MemoryStats{Usage: 1000, Stats: map[string]uint64{"total_inactive_file": 300}}, 700},
+		{"cgroup v2", container.MemoryStats{Usage: 1000, Stats: map[string]uint64{"inactive_file": 400}}, 600},
+		{"cache larger than usage", container.MemoryStats{Usage: 100, Stats: map[string]uint64{"inactive_file": 400}}, 100},
+		{"windows", container.MemoryStats{PrivateWorkingSet: 2048}, 2048},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculateMemoryUsage(&tt.mem); got != tt.want {
+				t.Errorf("calculateMemoryUsage() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCalculateContainerStats tests memory, network, block I/O and PID computation
+func TestCalculateContainerStats(t *testing.T) {
+	now := time.Now()
+	previous := &container.StatsResponse{
+		Read:     now.Add(-5 * time.Second),
+		Networks: map[string]container.NetworkStats{"eth0": {RxBytes: 1000, TxBytes: 500}},
+		BlkioStats: container.BlkioStats{IoServiceBytesRecursive: []container.BlkioStatEntry{
+			{Op: "Read", Value: 10000},
+			{Op: "Write", Value: 0},
+		}},
+	}
+	current := &container.StatsResponse{
+		Read:        now,
+		PidsStats:   container.PidsStats{Current: 12},
+		MemoryStats: container.MemoryStats{Usage: 600, Limit: 1000, Stats: map[string]uint64{"inactive_file": 100}},
+		Networks: map[string]container.NetworkStats{
+			"eth0": {RxBytes: 6000, TxBytes: 1500},
+			"eth1": {RxBytes: 5000, TxBytes: 0},
+		},
+		BlkioStats: container.BlkioStats{IoServiceBytesRecursive: []container.BlkioStatEntry{
+			{Op: "read", Value: 20000},
+			{Op: "write", Value: 5000},
+		}},
+	}
+
+	got := calculateContainerStats(current, previous)
+
+	if got.MemUsage != 500 || got.MemLimit != 1000 || got.MemPercent != 50 {
+		t.Errorf("Memory = %d/%d (%.1f%%), want 500/1000 (50%%)", got.MemUsage, got.MemLimit, got.MemPercent)
+	}
+	if got.PIDs != 12 {
+		t.Errorf("PIDs = %d, want 12", got.PIDs)
+	}
+	// (6000+5000-1000)/5s = 2000 B/s, (1500-500)/5s = 200 B/s
+	if got.NetRxRate != 2000 || got.NetTxRate != 200 {
+		t.Errorf("Net rates = %v/%v, want 2000/200", got.NetRxRate, got.NetTxRate)
+	}
+	if got.BlockReadRate != 2000 || got.BlockWriteRate != 1000 {
+		t.Errorf("Block rates = %v/%v, want 2000/1000", got.BlockReadRate, got.BlockWriteRate)
+	}
+
+	// No previous sample: no rates, but instant values are available
+	first := calculateContainerStats(current, nil)
+	if first.NetRxRate != 0 || first.BlockReadRate != 0 {
+		t.Error("Expected zero rates without previous sample")
+	}
+	if first.MemUsage != 500 {
+		t.Errorf("Expected memory without previous sample, got %d", first.MemUsage)
+	}
+
+	// Counter reset (container restarted between samples) must not produce a huge rate
+	if rate := counterRate(100, 5000, 5); rate != 0 {
+		t.Errorf("counterRate() after reset = %v, want 0", rate)
+	}
+}
+
 // BenchmarkCalculateCPUPercent benchmarks CPU calculation performance
 func BenchmarkCalculateCPUPercent(b *testing.B) {
 	current := &container.StatsResponse{
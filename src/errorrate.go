@@ -0,0 +1,151 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Error rate of a container: lines classified ERROR/FATAL (see detectLogLevel) or matching one of
+// the --error-pattern regular expressions, counted in 10s buckets over the last 5 minutes.
+
+const (
+	errorBucketWidth    = 10 * time.Second
+	errorBucketCount    = 30 // 5 minutes
+	errorSparklineWidth = 10 // One character per 30s
+)
+
+// errorRateTracker counts error lines of one container in time buckets
+type errorRateTracker struct {
+	buckets [errorBucketCount]int
+	last    int64 // Absolute index of the newest bucket (unix time / bucket width)
+	mu      sync.Mutex
+}
+
+// ErrorStats are the error rates of a container
+type ErrorStats struct {
+	PerMinute1m float64 // Errors in the last minute
+	PerMinute5m float64 // Average errors per minute over the last 5 minutes
+	History     []int   // Errors per 30s over the last 5 minutes, oldest first (sparkline)
+}
+
+// bucketIndex returns the absolute bucket index of a time
+func bucketIndex(t time.Time) int64 {
+	return t.UnixNano() / int64(errorBucketWidth)
+}
+
+// advance clears the buckets between the newest bucket and now (caller holds mu)
+func (t *errorRateTracker) advance(now time.Time) {
+	current := bucketIndex(now)
+	if current <= t.last {
+		return
+	}
+	if current-t.last >= errorBucketCount {
+		t.buckets = [errorBucketCount]int{}
+	} else {
+		for i := t.last + 1; i <= current; i++ {
+			t.buckets[i%errorBucketCount] = 0
+		}
+	}
+	t.last = current
+}
+
+// add records an error line logged at at (Docker timestamp, zero = now)
+// Lines older than the window (replayed tails) are dropped; future times count as now.
+func (t *errorRateTracker) add(at, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.advance(now)
+	index := t.last
+	if !at.IsZero() && bucketIndex(at) < t.last {
+		index = bucketIndex(at)
+	}
+	if index <= t.last-errorBucketCount {
+		return
+	}
+	t.buckets[index%errorBucketCount]++
+}
+
+// stats returns the error rates at now
+func (t *errorRateTracker) stats(now time.Time) ErrorStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.advance(now)
+
+	// counts[0] is the oldest bucket, counts[errorBucketCount-1] the current one
+	counts := make([]int, errorBucketCount)
+	for i := range counts {
+		counts[i] = t.buckets[(t.last-int64(errorBucketCount-1-i))%errorBucketCount]
+	}
+
+	stats := ErrorStats{History: make([]int, errorSparklineWidth)}
+	perMinute := int(time.Minute / errorBucketWidth)
+	total := 0
+	for i, count := range counts {
+		total += count
+		if i >= errorBucketCount-perMinute {
+			stats.PerMinute1m += float64(count)
+		}
+		stats.History[i*errorSparklineWidth/errorBucketCount] += count
+	}
+	stats.PerMinute5m = float64(total) / (float64(errorBucketCount*errorBucketWidth) / float64(time.Minute))
+	return stats
+}
+
+// compileErrorPatterns compiles --error-pattern values (case-insensitive)
+func compileErrorPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// isErrorLine reports whether a log line counts in the error rate
+func isErrorLine(line string, patterns []*regexp.Regexp) bool {
+	if detectLogLevel(line, nil).isErrorLevel() {
+		return true
+	}
+	if len(patterns) == 0 {
+		return false
+	}
+	if strings.IndexByte(line, '\x1b') >= 0 {
+		line = stripAnsiCodes(line)
+	}
+	for _, re := range patterns {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// sparklineLevels are the characters of a sparkline, lowest first
+var sparklineLevels = []rune("▁▂▃▄▅▆▇█")
+
+// formatSparkline renders values as a sparkline scaled to the largest value (blank when all zero)
+func formatSparkline(values []int) string {
+	peak := 0
+	for _, v := range values {
+		peak = max(peak, v)
+	}
+	if peak == 0 {
+		return strings.Repeat(" ", len(values))
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if v == 0 {
+			sb.WriteRune(' ')
+			continue
+		}
+		level := (v*len(sparklineLevels) - 1) / peak
+		sb.WriteRune(sparklineLevels[min(level, len(sparklineLevels)-1)])
+	}
+	return sb.String()
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// TestErrorRateTrackerWindows tests the 1m and 5m error rates and bucket expiry
+func TestErrorRateTrackerWindows(t *testing.T) {
+	tracker := &errorRateTracker{}
+	start := time.Unix(1700000000, 0)
+
+	// 10 errors 4 minutes ago, 3 errors in the last minute
+	for i := 0; i < 10; i++ {
+		tracker.add(start, start)
+	}
+	now := start.Add(4 * time.Minute)
+	for i := 0; i < 3; i++ {
+		tracker.add(now.Add(-20*time.Second), now)
+	}
+
+	stats := tracker.stats(now)
+	if stats.PerMinute1m != 3 {
+		t.Errorf("PerMinute1m = %f, want 3", stats.PerMinute1m)
+	}
+	if stats.PerMinute5m != 13.0/5 {
+		t.Errorf("PerMinute5m = %f, want %f", stats.PerMinute5m, 13.0/5)
+	}
+	if len(stats.History) != errorSparklineWidth {
+		t.Fatalf("History has %d values, want %d", len(stats.History), errorSparklineWidth)
+	}
+	total := 0
+	for _, v := range stats.History {
+		total += v
+	}
+	if total != 13 {
+		t.Errorf("History total = %d, want 13", total)
+	}
+	if stats.History[errorSparklineWidth-1] != 3 {
+		t.Errorf("Newest history value = %d, want 3", stats.History[errorSparklineWidth-1])
+	}
+
+	// Everything expires after 5 minutes
+	stats = tracker.stats(now.Add(6 * time.Minute))
+	if stats.PerMinute1m != 0 || stats.PerMinute5m != 0 {
+		t.Errorf("Expected expired rates, got %+v", stats)
+	}
+}
+
+// TestIsErrorLine tests error classification by level and --error-pattern
+func TestIsErrorLine(t *testing.T) {
+	patterns, err := compileErrorPatterns([]string{`exception`, `HTTP/1\.1" 5\d\d`})
+	if err != nil {
+		t.Fatalf("compileErrorPatterns: %v", err)
+	}
+
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{"ERROR connection refused", true},
+		{`{"level":"fatal","msg":"exiting"}`, true},
+		{"INFO started", false},
+		{"java.lang.NullPointerException at Foo.bar", true},
+		{`"GET /api HTTP/1.1" 502 12`, true},
+		{`"GET /api HTTP/1.1" 200 12`, false},
+	}
+	for _, tt := range tests {
+		if got := isErrorLine(tt.line, patterns); got != tt.want {
+			t.Errorf("isErrorLine(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+
+	if isErrorLine("java.lang.NullPointerException", nil) {
+		t.Error("Expected no match without patterns")
+	}
+	if _, err := compileErrorPatterns([]string{"("}); err == nil {
+		t.Error("Expected an error for an invalid pattern")
+	}
+}
+
+// TestFormatSparkline tests the error history sparkline
+func TestFormatSparkline(t *testing.T) {
+	if got := formatSparkline([]int{0, 0, 0}); got != "   " {
+		t.Errorf("Expected blank sparkline, got %q", got)
+	}
+	if got := formatSparkline([]int{0, 1, 4, 8}); got != " ▁▄█" {
+		t.Errorf("Expected scaled sparkline, got %q", got)
+	}
+}
+
+// TestRateTrackerErrorPatterns tests custom error patterns in the rate tracker
+func TestRateTrackerErrorPatterns(t *testing.T) {
+	tracker := NewRateTrackerConsumer()
+	patterns, _ := compileErrorPatterns([]string{"timeout"})
+	tracker.SetErrorPatterns(patterns)
+
+	tracker.OnLogLine("c1", "api", "upstream timeout after 30s", streamStdout, time.Now())
+	tracker.OnLogLine("c1", "api", "request ok", streamStdout, time.Now())
+
+	stats := tracker.GetErrorStats("c1")
+	if stats.PerMinute1m != 1 {
+		t.Errorf("PerMinute1m = %f, want 1", stats.PerMinute1m)
+	}
+	if stats.PerMinute5m != 0.2 {
+		t.Errorf("PerMinute5m = %f, want 0.2", stats.PerMinute5m)
+	}
+
+	// Replayed tail: errors logged before the window are not counted
+	tracker.OnLogLine("c1", "api", "ERROR old failure", streamStdout, time.Now().Add(-10*time.Minute))
+	tracker.OnLogLine("c1", "api", "ERROR earlier failure", streamStdout, time.Now().Add(-3*time.Minute))
+	stats = tracker.GetErrorStats("c1")
+	if stats.PerMinute1m != 1 || stats.PerMinute5m != 0.4 {
+		t.Errorf("Expected only the 3-minute-old error to be added, got %+v", stats)
+	}
+}
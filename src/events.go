@@ -0,0 +1,323 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/events"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/client"
+)
+
+// containerEventMsg carries an incremental container change from the Docker events stream
+type containerEventMsg struct {
+	action      string           // Normalized event action (start, die, destroy, health_status, ...)
+	containerID string           // Full container ID
+	container   *types.Container // Fresh container state (nil when the container was destroyed)
+	source      *EventWatcher    // Watcher that published the change (multi-host)
+}
+
+// dockerResyncMsg carries a full container list after (re)connecting to the events stream
+type dockerResyncMsg struct {
+	containers []types.Container
+	source     *EventWatcher // Watcher that published the list (multi-host)
+}
+
+// watchedContainerActions lists the container event actions that change what the list shows
+var watchedContainerActions = []string{
+	"create", "start", "restart", "stop", "die", "kill", "oom",
+	"destroy", "pause", "unpause", "rename", "health_status",
+}
+
+// EventWatcher subscribes to Docker events and keeps the container list in sync
+// It replaces periodic ContainerList polling: changes are applied incrementally and a
+// full resync only happens when the events stream is (re)established.
+type EventWatcher struct {
+	dockerClient *client.Client
+	logBroker    *LogBroker
+
+	containers   []types.Container
+	containersMu sync.RWMutex
+
+	msgChan       chan tea.Msg // Notifications for the TUI (buffered, never blocks the watcher)
+	resyncPending atomic.Bool  // True when a notification was dropped and the TUI needs a full list
+
+	cancel   context.CancelFunc
+	cancelMu sync.Mutex
+}
+
+// NewEventWatcher creates a new EventWatcher instance
+func NewEventWatcher(dockerClient *client.Client, logBroker *LogBroker) *EventWatcher {
+	return &EventWatcher{
+		dockerClient: dockerClient,
+		logBroker:    logBroker,
+		containers:   []types.Container{},
+		msgChan:      make(chan tea.Msg, 256),
+	}
+}
+
+// Start launches the events subscription in the background
+func (ew *EventWatcher) Start() {
+	ew.cancelMu.Lock()
+	defer ew.cancelMu.Unlock()
+	if ew.cancel != nil {
+		return // Already running
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ew.cancel = cancel
+	safeGo("docker-events", func() {
+		ew.run(ctx)
+	})
+}
+
+// Stop cancels the events subscription
+func (ew *EventWatcher) Stop() {
+	ew.cancelMu.Lock()
+	defer ew.cancelMu.Unlock()
+	if ew.cancel != nil {
+		ew.cancel()
+		ew.cancel = nil
+	}
+}
+
+// Updates returns the channel on which container changes are published for the TUI
+func (ew *EventWatcher) Updates() <-chan tea.Msg {
+	return ew.msgChan
+}
+
+// Drain discards the queued notifications
+// The TUI takes a GetContainers snapshot right after (host switch): later changes apply on top of it.
+func (ew *EventWatcher) Drain() {
+	for {
+		select {
+		case <-ew.msgChan:
+		default:
+			ew.resyncPending.Store(false)
+			return
+		}
+	}
+}
+
+// GetContainers returns a copy of the current container list
+func (ew *EventWatcher) GetContainers() []types.Container {
+	ew.containersMu.RLock()
+	defer ew.containersMu.RUnlock()
+	result := make([]types.Container, len(ew.containers))
+	copy(result, ew.containers)
+	return result
+}
+
+// run subscribes to the events stream and reconnects with backoff when it breaks
+func (ew *EventWatcher) run(ctx context.Context) {
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		streamCtx, streamCancel := context.WithCancel(ctx)
+		msgs, errs := ew.dockerClient.Events(streamCtx, events.ListOptions{
+			Filters: containerEventFilters(),
+		})
+
+		// Subscribe BEFORE listing so that no change can slip between the list and the stream
+		if err := ew.resync(ctx); err != nil {
+			streamCancel()
+			if !sleepCtx(ctx, backoff) {
+				return
+			}
+			backoff = nextBackoff(backoff, maxBackoff)
+			continue
+		}
+		backoff = time.Second
+
+		streamBroken := false
+		for !streamBroken {
+			select {
+			case <-ctx.Done():
+				streamCancel()
+				return
+			case msg := <-msgs:
+				ew.handleEvent(ctx, msg)
+			case <-errs:
+				// Stream closed (daemon restart, network error...): reconnect and resync
+				streamBroken = true
+			}
+		}
+		streamCancel()
+
+		if !sleepCtx(ctx, backoff) {
+			return
+		}
+		backoff = nextBackoff(backoff, maxBackoff)
+	}
+}
+
+// resync loads the full container list and publishes it
+func (ew *EventWatcher) resync(ctx context.Context) error {
+	listCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	containers, err := ew.dockerClient.ContainerList(listCtx, container.ListOptions{All: true})
+	if err != nil {
+		return err
+	}
+	sortContainersByName(containers)
+
+	ew.containersMu.Lock()
+	ew.containers = containers
+	ew.containersMu.Unlock()
+
+	ew.publish(true, nil)
+	return nil
+}
+
+// handleEvent applies a single container event to the list and publishes it
+func (ew *EventWatcher) handleEvent(ctx context.Context, msg events.Message) {
+	if msg.Type != events.ContainerEventType || msg.Actor.ID == "" {
+		return
+	}
+	action := normalizeEventAction(string(msg.Action))
+
+	var fresh *types.Container
+	if action != "destroy" {
+		// Fetch only the affected container (cheap compared to a full list)
+		listCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		list, err := ew.dockerClient.ContainerList(listCtx, container.ListOptions{
+			All:     true,
+			Filters: filters.NewArgs(filters.Arg("id", msg.Actor.ID)),
+		})
+		cancel()
+		if err != nil {
+			return
+		}
+		if len(list) > 0 {
+			fresh = &list[0]
+		}
+		// Container disappeared between the event and the lookup: treat as destroyed
+	}
+
+	ew.containersMu.Lock()
+	ew.containers = applyContainerEvent(ew.containers, msg.Actor.ID, fresh)
+	ew.containersMu.Unlock()
+
+	ew.publish(false, &containerEventMsg{
+		action:      action,
+		containerID: msg.Actor.ID,
+		container:   fresh,
+	})
+}
+
+// publish pushes the current state to the LogBroker and notifies the TUI
+func (ew *EventWatcher) publish(full bool, event *containerEventMsg) {
+	snapshot := ew.GetContainers()
+
+	if ew.logBroker != nil {
+		ew.logBroker.StartStreaming(snapshot)
+	}
+
+	// A previously dropped notification means the TUI is out of sync: send the full list instead
+	var msg tea.Msg
+	if full || ew.resyncPending.Load() {
+		msg = dockerResyncMsg{containers: snapshot, source: ew}
+	} else {
+		event.source = ew
+		msg = *event
+	}
+
+	select {
+	case ew.msgChan <- msg:
+		if _, isResync := msg.(dockerResyncMsg); isResync {
+			ew.resyncPending.Store(false)
+		}
+	default:
+		// Channel full (TUI not reading): remember to resync on next delivery
+		ew.resyncPending.Store(true)
+	}
+}
+
+// waitForDockerEvent listens on the EventWatcher channel for the next container change
+func waitForDockerEvent(ch <-chan tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return <-ch
+	}
+}
+
+// containerEventFilters builds the events filter for container state changes
+func containerEventFilters() filters.Args {
+	args := filters.NewArgs(filters.Arg("type", string(events.ContainerEventType)))
+	for _, action := range watchedContainerActions {
+		args.Add("event", action)
+	}
+	return args
+}
+
+// normalizeEventAction strips the free-form suffix of actions like "health_status: healthy"
+func normalizeEventAction(action string) string {
+	if idx := strings.Index(action, ":"); idx >= 0 {
+		return strings.TrimSpace(action[:idx])
+	}
+	return action
+}
+
+// applyContainerEvent returns the container list with one container updated, inserted or removed
+// A nil container removes the entry. The list stays sorted by name.
+func applyContainerEvent(containers []types.Container, containerID string, c *types.Container) []types.Container {
+	result := make([]types.Container, 0, len(containers)+1)
+	for _, existing := range containers {
+		if existing.ID != containerID {
+			result = append(result, existing)
+		}
+	}
+	if c != nil {
+		result = append(result, *c)
+		sortContainersByName(result)
+	}
+	return result
+}
+
+// sortContainersByName sorts containers by their first name
+func sortContainersByName(containers []types.Container) {
+	sort.SliceStable(containers, func(i, j int) bool {
+		// CRITICAL FIX: Protect against empty Names slice (Docker edge case)
+		nameI := ""
+		if len(containers[i].Names) > 0 {
+			nameI = containers[i].Names[0]
+		}
+		nameJ := ""
+		if len(containers[j].Names) > 0 {
+			nameJ = containers[j].Names[0]
+		}
+		return nameI < nameJ
+	})
+}
+
+// nextBackoff doubles the reconnect delay up to the given limit
+func nextBackoff(current, limit time.Duration) time.Duration {
+	if current*2 > limit {
+		return limit
+	}
+	return current * 2
+}
+
+// sleepCtx waits for the given duration, returning false if the context was cancelled first
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+// TestNormalizeEventAction tests stripping of free-form event suffixes
+func TestNormalizeEventAction(t *testing.T) {
+	tests := []struct {
+		action   string
+		expected string
+	}{
+		{"start", "start"},
+		{"die", "die"},
+		{"health_status: healthy", "health_status"},
+		{"health_status: unhealthy", "health_status"},
+		{"exec_start: /bin/sh -c 'echo hello'", "exec_start"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeEventAction(tt.action); got != tt.expected {
+			t.Errorf("normalizeEventAction(%q) = %q, want %q", tt.action, got, tt.expected)
+		}
+	}
+}
+
+// TestApplyContainerEvent tests incremental insert/update/remove on the container list
+func TestApplyContainerEvent(t *testing.T) {
+	containers := []types.Container{
+		{ID: "a", Names: []string{"/alpha"}, State: "running"},
+		{ID: "c", Names: []string{"/gamma"}, State: "running"},
+	}
+
+	// Insert keeps the list sorted by name
+	inserted := applyContainerEvent(containers, "b", &types.Container{ID: "b", Names: []string{"/beta"}, State: "created"})
+	if len(inserted) != 3 {
+		t.Fatalf("Expected 3 containers after insert, got %d", len(inserted))
+	}
+	if inserted[1].ID != "b" {
+		t.Errorf("Expected inserted container at index 1, got %q", inserted[1].ID)
+	}
+
+	// Update replaces the existing entry
+	updated := applyContainerEvent(inserted, "a", &types.Container{ID: "a", Names: []string{"/alpha"}, State: "exited"})
+	if len(updated) != 3 {
+		t.Fatalf("Expected 3 containers after update, got %d", len(updated))
+	}
+	if updated[0].State != "exited" {
+		t.Errorf("Expected updated state 'exited', got %q", updated[0].State)
+	}
+
+	// Nil container removes the entry
+	removed := applyContainerEvent(updated, "c", nil)
+	if len(removed) != 2 {
+		t.Fatalf("Expected 2 containers after remove, got %d", len(removed))
+	}
+	for _, c := range removed {
+		if c.ID == "c" {
+			t.Error("Removed container still present")
+		}
+	}
+
+	// Original slice must not be modified
+	if containers[0].State != "running" || len(containers) != 2 {
+		t.Error("applyContainerEvent modified its input slice")
+	}
+}
+
+// TestContainerEventFilters tests the events filter sent to the daemon
+func TestContainerEventFilters(t *testing.T) {
+	args := containerEventFilters()
+
+	if !args.ExactMatch("type", "container") {
+		t.Error("Expected type=container filter")
+	}
+	for _, action := range []string{"start", "die", "destroy", "pause", "health_status"} {
+		if !args.ExactMatch("event", action) {
+			t.Errorf("Expected event filter to include %q", action)
+		}
+	}
+}
+
+// TestNextBackoff tests the reconnect backoff progression
+func TestNextBackoff(t *testing.T) {
+	if got := nextBackoff(time.Second, 30*time.Second); got != 2*time.Second {
+		t.Errorf("nextBackoff(1s) = %v, want 2s", got)
+	}
+	if got := nextBackoff(20*time.Second, 30*time.Second); got != 30*time.Second {
+		t.Errorf("nextBackoff(20s) = %v, want 30s (capped)", got)
+	}
+}
+
+// TestEventWatcherPublishDropsToResync tests that a full channel triggers a resync message
+func TestEventWatcherPublishDropsToResync(t *testing.T) {
+	ew := NewEventWatcher(nil, nil)
+	ew.msgChan = make(chan tea.Msg, 1)
+	ew.containers = []types.Container{{ID: "a", Names: []string{"/alpha"}}}
+
+	event := &containerEventMsg{action: "start", containerID: "a"}
+	ew.publish(false, event) // fills the channel
+	ew.publish(false, event) // dropped
+
+	if !ew.resyncPending.Load() {
+		t.Fatal("Expected resyncPending after dropped notification")
+	}
+
+	// Drain, then the next publish must be a full resync
+	<-ew.msgChan
+	ew.publish(false, event)
+	msg := <-ew.msgChan
+	if _, ok := msg.(dockerResyncMsg); !ok {
+		t.Errorf("Expected dockerResyncMsg after drop, got %T", msg)
+	}
+	if ew.resyncPending.Load() {
+		t.Error("Expected resyncPending cleared after resync delivery")
+	}
+}
+
+// TestUpdate_ContainerEventMsg tests incremental container updates in the model
+func TestUpdate_ContainerEventMsg(t *testing.T) {
+	m := &model{
+		containers: []types.Container{
+			{ID: "a", Names: []string{"/alpha"}, State: "running"},
+			{ID: "b", Names: []string{"/beta"}, State: "running"},
+		},
+		selected:     map[string]bool{"b": true},
+		processing:   map[string]bool{"b": true},
+		cpuStats:     map[string][]float64{"b": {1.0}},
+		cpuCurrent:   map[string]float64{"b": 1.0},
+		cpuPrevStats: make(map[string]*container.StatsResponse),
+		cursor:       1,
+	}
+
+	// State change
+	newModel, _ := m.Update(containerEventMsg{
+		action:      "die",
+		containerID: "a",
+		container:   &types.Container{ID: "a", Names: []string{"/alpha"}, State: "exited"},
+	})
+	m = newModel.(*model)
+	if m.containers[0].State != "exited" {
+		t.Errorf("Expected state 'exited', got %q", m.containers[0].State)
+	}
+
+	// Destroy removes the container and its per-container state
+	newModel, _ = m.Update(containerEventMsg{action: "destroy", containerID: "b"})
+	m = newModel.(*model)
+	if len(m.containers) != 1 {
+		t.Fatalf("Expected 1 container after destroy, got %d", len(m.containers))
+	}
+	if m.cursor != 0 {
+		t.Errorf("Expected cursor clamped to 0, got %d", m.cursor)
+	}
+	if _, ok := m.cpuStats["b"]; ok {
+		t.Error("Expected cpuStats cleaned up for destroyed container")
+	}
+	if m.selected["b"] || m.processing["b"] {
+		t.Error("Expected selection/processing cleaned up for destroyed container")
+	}
+}
+
+// TestUpdate_TickMsgWithEventWatcher tests that polling is disabled when events are available
+func TestUpdate_TickMsgWithEventWatcher(t *testing.T) {
+	m := &model{eventWatcher: NewEventWatcher(nil, nil)}
+
+	newModel, cmd := m.Update(tickMsg(time.Now()))
+	m = newModel.(*model)
+
+	if m.spinnerFrame != 1 {
+		t.Errorf("Expected spinnerFrame=1, got %d", m.spinnerFrame)
+	}
+	if cmd == nil {
+		t.Error("Expected tick command to be rescheduled")
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/muesli/cancelreader"
+)
+
+// shellCommand starts bash when available, plain sh otherwise
+var shellCommand = []string{"/bin/sh", "-c", "command -v bash >/dev/null 2>&1 && exec bash || exec sh"}
+
+// execFinishedMsg is sent when an interactive shell session ends
+type execFinishedMsg struct {
+	containerName string
+	err           error
+}
+
+// containerShell implements tea.ExecCommand to run an interactive TTY shell inside a container
+// bubbletea releases the terminal (alt-screen, raw input) while Run() is executing
+type containerShell struct {
+	dockerClient *client.Client
+	containerID  string
+	stdin        io.Reader
+	stdout       io.Writer
+	stderr       io.Writer
+}
+
+func (s *containerShell) SetStdin(r io.Reader)  { s.stdin = r }
+func (s *containerShell) SetStdout(w io.Writer) { s.stdout = w }
+func (s *containerShell) SetStderr(w io.Writer) { s.stderr = w }
+
+// Run creates the exec instance, attaches the terminal to it and blocks until the shell exits
+func (s *containerShell) Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if s.stdin == nil {
+		s.stdin = os.Stdin
+	}
+	if s.stdout == nil {
+		s.stdout = os.Stdout
+	}
+
+	fd, isTerminal := terminalFd(s.stdin)
+	var consoleSize *[2]uint
+	if isTerminal {
+		if width, height, err := term.GetSize(fd); err == nil {
+			consoleSize = &[2]uint{uint(height), uint(width)}
+		}
+	}
+
+	termEnv := os.Getenv("TERM")
+	if termEnv == "" {
+		termEnv = "xterm"
+	}
+
+	created, err := s.dockerClient.ContainerExecCreate(ctx, s.containerID, container.ExecOptions{
+		Tty:          true,
+		AttachStdin:  true,
+		AttachStdout: true,
+		AttachStderr: true,
+		ConsoleSize:  consoleSize,
+		Env:          []string{"TERM=" + termEnv},
+		Cmd:          shellCommand,
+	})
+	if err != nil {
+		return fmt.Errorf("exec create: %w", err)
+	}
+
+	attach, err := s.dockerClient.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{
+		Tty:         true,
+		ConsoleSize: consoleSize,
+	})
+	if err != nil {
+		return fmt.Errorf("exec attach: %w", err)
+	}
+	defer attach.Close()
+
+	// Raw mode so that keys (Ctrl+C, arrows, tab...) reach the shell untouched
+	if isTerminal {
+		if state, err := term.MakeRaw(fd); err == nil {
+			defer term.Restore(fd, state)
+		}
+		safeGo(fmt.Sprintf("exec-resize-%s", s.containerID[:min(12, len(s.containerID))]), func() {
+			s.forwardResize(ctx, fd, created.ID)
+		})
+	}
+
+	// CRITICAL: Use a cancelable reader for stdin, otherwise the copy goroutine would
+	// keep reading after the shell exits and steal the next keystroke from bubbletea
+	input, err := cancelreader.NewReader(s.stdin)
+	if err != nil {
+		return fmt.Errorf("stdin: %w", err)
+	}
+	defer input.Close()
+
+	safeGo("exec-stdin", func() {
+		io.Copy(attach.Conn, input)
+		attach.CloseWrite()
+	})
+
+	// TTY output is raw (not multiplexed): copy until the shell exits
+	io.Copy(s.stdout, attach.Reader)
+	input.Cancel()
+
+	inspectCtx, inspectCancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer inspectCancel()
+	inspect, err := s.dockerClient.ContainerExecInspect(inspectCtx, created.ID)
+	if err == nil && (inspect.ExitCode == 126 || inspect.ExitCode == 127) {
+		// Any other exit code is the shell's own business (last command status)
+		return fmt.Errorf("no usable shell in container (exit code %d)", inspect.ExitCode)
+	}
+	return nil
+}
+
+// forwardResize propagates terminal size changes to the exec TTY
+// Polling keeps this portable (no SIGWINCH on Windows) and costs one ioctl every 250ms
+func (s *containerShell) forwardResize(ctx context.Context, fd uintptr, execID string) {
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	lastWidth, lastHeight := -1, -1
+	for {
+		width, height, err := term.GetSize(fd)
+		if err == nil && (width != lastWidth || height != lastHeight) {
+			lastWidth, lastHeight = width, height
+			resizeCtx, resizeCancel := context.WithTimeout(ctx, 2*time.Second)
+			s.dockerClient.ContainerExecResize(resizeCtx, execID, container.ResizeOptions{
+				Height: uint(height),
+				Width:  uint(width),
+			})
+			resizeCancel()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// terminalFd returns the file descriptor of r when it is a terminal
+func terminalFd(r io.Reader) (uintptr, bool) {
+	f, ok := r.(interface{ Fd() uintptr })
+	if !ok {
+		return 0, false
+	}
+	return f.Fd(), term.IsTerminal(f.Fd())
+}
+
+// openShell suspends the TUI and opens an interactive shell in the given container
+func (m *model) openShell(containerID, containerName string) tea.Cmd {
+	shell := &containerShell{
+		dockerClient: m.dockerClient,
+		containerID:  containerID,
+	}
+	return tea.Exec(shell, func(err error) tea.Msg {
+		return execFinishedMsg{containerName: containerName, err: err}
+	})
+}
+
+// shellTarget returns the container to open a shell in (single selection or cursor)
+// An error message is returned when the target is ambiguous or not running.
+func (m *model) shellTarget() (id, name, errMsg string) {
+	selected := m.getSelectedIDs()
+	if m.countSelected() > 1 || len(selected) > 1 {
+		return "", "", "shell: select a single container"
+	}
+	if len(selected) == 0 {
+		return "", "", ""
+	}
+
+	m.containersMu.RLock()
+	defer m.containersMu.RUnlock()
+	for _, c := range m.containers {
+		if c.ID == selected[0] {
+			name = getContainerName(c)
+			if c.State != "running" {
+				return "", "", fmt.Sprintf("shell: %s is not running", name)
+			}
+			return c.ID, name, ""
+		}
+	}
+	return "", "", ""
+}
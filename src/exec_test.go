@@ -0,0 +1,95 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+)
+
+// TestShellTarget tests container resolution for the exec shell
+func TestShellTarget(t *testing.T) {
+	m := createTestModel()
+	m.containers = []types.Container{
+		{ID: "container-running-1", Names: []string{"/web"}, State: "running"},
+		{ID: "container-stopped-2", Names: []string{"/db"}, State: "exited"},
+	}
+
+	// Cursor container (no selection)
+	m.cursor = 0
+	id, name, errMsg := m.shellTarget()
+	if errMsg != "" || id != "container-running-1" || name != "web" {
+		t.Errorf("shellTarget() = (%q, %q, %q), want cursor container", id, name, errMsg)
+	}
+
+	// Stopped container
+	m.cursor = 1
+	_, _, errMsg = m.shellTarget()
+	if !strings.Contains(errMsg, "not running") {
+		t.Errorf("Expected 'not running' error, got %q", errMsg)
+	}
+
+	// Multiple selection is ambiguous
+	m.selected["container-running-1"] = true
+	m.selected["container-stopped-2"] = true
+	_, _, errMsg = m.shellTarget()
+	if !strings.Contains(errMsg, "single container") {
+		t.Errorf("Expected 'single container' error, got %q", errMsg)
+	}
+}
+
+// TestHandleKeyPress_ShellStoppedContainer tests that E on a stopped container shows a toast
+func TestHandleKeyPress_ShellStoppedContainer(t *testing.T) {
+	m := createTestModel()
+	m.containers = []types.Container{
+		{ID: "container-stopped-1", Names: []string{"/db"}, State: "exited"},
+	}
+
+	_, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if cmd == nil {
+		t.Fatal("Expected a toast command")
+	}
+	msg, ok := cmd().(toastMsg)
+	if !ok || !msg.isError {
+		t.Errorf("Expected error toastMsg, got %#v", msg)
+	}
+}
+
+// TestHandleKeyPress_ShellRunningContainer tests that E on a running container returns an exec command
+func TestHandleKeyPress_ShellRunningContainer(t *testing.T) {
+	m := createTestModel()
+	m.containers = []types.Container{
+		{ID: "container-running-1", Names: []string{"/web"}, State: "running"},
+	}
+
+	_, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	if cmd == nil {
+		t.Fatal("Expected an exec command")
+	}
+	if m.view != listView {
+		t.Errorf("Expected to stay in listView, got %v", m.view)
+	}
+}
+
+// TestTerminalFdNonFile tests that non-file readers are not treated as terminals
+func TestTerminalFdNonFile(t *testing.T) {
+	if _, ok := terminalFd(strings.NewReader("")); ok {
+		t.Error("Expected strings.Reader not to be a terminal")
+	}
+}
+
+// TestUpdate_ExecFinishedMsg tests toast on shell failure
+func TestUpdate_ExecFinishedMsg(t *testing.T) {
+	m := createTestModel()
+
+	_, cmd := m.Update(execFinishedMsg{containerName: "web"})
+	if cmd == nil {
+		t.Error("Expected redraw command after shell exit")
+	}
+
+	_, cmd = m.Update(execFinishedMsg{containerName: "web", err: &testError{msg: "boom"}})
+	if cmd == nil {
+		t.Error("Expected command with toast after shell failure")
+	}
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileSinkConsumer persists every streamed log line to disk (--log-dir), so that the logs of a
+// removed container survive. Files are rotated by size and/or age, rotated files are gzipped in
+// the background and only the newest MaxFiles of each log file are kept.
+//
+// Layout:
+//
+//	<dir>/<container name>.log                       one file per container: "<time> <stream> <line>"
+//	<dir>/docker-tui.jsonl                           combined mode: one JSON object per line
+//	<dir>/<file>.20250301-102030.123.gz              rotated files
+
+// FileSinkOptions configures a FileSinkConsumer
+type FileSinkOptions struct {
+	Dir      string
+	Combined bool          // One JSONL file for all containers instead of one file per container
+	MaxSize  int64         // Rotate a file when it exceeds this size in bytes (0 = no size rotation)
+	MaxAge   time.Duration // Rotate a file when it was opened longer ago (0 = no time rotation)
+	MaxFiles int           // Rotated files kept per log file (0 = keep all)
+}
+
+// fileSinkCombinedName is the file name of the combined JSONL file
+const fileSinkCombinedName = "docker-tui.jsonl"
+
+// fileSinkRotateFormat is the suffix of rotated files (sorts chronologically)
+const fileSinkRotateFormat = "20060102-150405.000"
+
+// fileSinkResumeTail is how much of the end of a log file is read to find where it stopped
+const fileSinkResumeTail = 64 << 10
+
+// fileSinkNameRe matches characters not allowed in sink file names
+var fileSinkNameRe = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// sinkFile is an open log file of the sink
+type sinkFile struct {
+	path   string
+	file   *os.File
+	size   int64
+	opened time.Time
+	resume time.Time // Newest line already in the file when opened: replayed tails are skipped up to it
+}
+
+// sinkRecord is a line of the combined JSONL file
+type sinkRecord struct {
+	Time        string `json:"time"`
+	ContainerID string `json:"container_id"`
+	Container   string `json:"container"`
+	Stream      string `json:"stream,omitempty"`
+	Line        string `json:"line"`
+}
+
+// FileSinkConsumer implements LogConsumer to write logs to files
+type FileSinkConsumer struct {
+	opts       FileSinkOptions
+	files      map[string]*sinkFile // By container ID ("" for the combined file)
+	filesMu    sync.Mutex
+	closed     bool
+	compressWg sync.WaitGroup // Background gzip of rotated files
+}
+
+// NewFileSinkConsumer creates the log directory and a new instance
+func NewFileSinkConsumer(opts FileSinkOptions) (*FileSinkConsumer, error) {
+	if err := os.MkdirAll(opts.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create log directory: %w", err)
+	}
+	return &FileSinkConsumer{
+		opts:  opts,
+		files: make(map[string]*sinkFile),
+	}, nil
+}
+
+// sinkFileName returns a file name safe for any container or host name
+func sinkFileName(name string) string {
+	name = fileSinkNameRe.ReplaceAllString(strings.TrimPrefix(name, "/"), "_")
+	if name == "" || name == "." || name == ".." {
+		name = "_"
+	}
+	return name
+}
+
+// OnLogLine is called when a new log line arrives
+func (fs *FileSinkConsumer) OnLogLine(containerID, containerName, line, stream string, timestamp time.Time) {
+	var data []byte
+	key := containerID
+	path := filepath.Join(fs.opts.Dir, sinkFileName(containerName)+".log")
+	if fs.opts.Combined {
+		key = ""
+		path = filepath.Join(fs.opts.Dir, fileSinkCombinedName)
+		record, err := json.Marshal(sinkRecord{
+			Time:        timestamp.UTC().Format(time.RFC3339Nano),
+			ContainerID: containerID,
+			Container:   containerName,
+			Stream:      stream,
+			Line:        line,
+		})
+		if err != nil {
+			return
+		}
+		data = append(record, '\n')
+	} else {
+		if stream == "" {
+			stream = streamStdout
+		}
+		data = []byte(timestamp.UTC().Format(time.RFC3339Nano) + " " + stream + " " + line + "\n")
+	}
+
+	fs.filesMu.Lock()
+	defer fs.filesMu.Unlock()
+	if fs.closed {
+		return
+	}
+
+	f := fs.files[key]
+	var resume time.Time
+	if f != nil && fs.needsRotation(f, int64(len(data))) {
+		fs.rotate(f)
+		delete(fs.files, key)
+		resume = f.resume // The rotated file holds the lines a replay may still send
+		f = nil
+	}
+	if f == nil {
+		var err error
+		if f, err = openSinkFile(path, fs.opts.Combined); err != nil {
+			return // Directory removed or not writable: retried on the next line
+		}
+		if resume.After(f.resume) {
+			f.resume = resume
+		}
+		fs.files[key] = f
+	}
+
+	// LogBroker replays the last lines of each container when it starts streaming:
+	// lines persisted by a previous run are not written twice
+	if !timestamp.IsZero() && !timestamp.After(f.resume) {
+		return
+	}
+
+	// Unbuffered: a line is on disk as soon as it is written, even if docker-tui crashes
+	n, _ := f.file.Write(data)
+	f.size += int64(n)
+}
+
+// OnContainerStatusChange is called when a container changes state
+// The file of a stopped container is closed; it is reopened (appended) if the container restarts.
+func (fs *FileSinkConsumer) OnContainerStatusChange(containerID string, isRunning bool) {
+	if isRunning || fs.opts.Combined {
+		return
+	}
+	fs.filesMu.Lock()
+	defer fs.filesMu.Unlock()
+	if f := fs.files[containerID]; f != nil {
+		f.file.Close()
+		delete(fs.files, containerID)
+	}
+}
+
+// Close closes all files and waits for the pending compressions
+func (fs *FileSinkConsumer) Close() {
+	fs.filesMu.Lock()
+	fs.closed = true
+	for key, f := range fs.files {
+		f.file.Close()
+		delete(fs.files, key)
+	}
+	fs.filesMu.Unlock()
+
+	fs.compressWg.Wait()
+}
+
+// openSinkFile opens a log file for appending
+func openSinkFile(path string, combined bool) (*sinkFile, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	return &sinkFile{path: path, file: file, size: info.Size(), opened: time.Now(), resume: lastSinkTime(path, combined)}, nil
+}
+
+// lastSinkTime returns the newest line time in the end of a log file (zero time if none)
+// Combined files interleave containers: the newest of the last fileSinkResumeTail bytes is used.
+func lastSinkTime(path string, combined bool) time.Time {
+	file, err := os.Open(path)
+	if err != nil {
+		return time.Time{}
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return time.Time{}
+	}
+	offset := int64(0)
+	if info.Size() > fileSinkResumeTail {
+		offset = info.Size() - fileSinkResumeTail
+	}
+	data := make([]byte, info.Size()-offset)
+	if _, err := file.ReadAt(data, offset); err != nil && err != io.EOF {
+		return time.Time{}
+	}
+
+	lines := strings.Split(string(data), "\n")
+	if offset > 0 {
+		lines = lines[1:] // Partial first line
+	}
+	var last time.Time
+	for _, line := range lines {
+		value, _, _ := strings.Cut(line, " ")
+		if combined {
+			var record sinkRecord
+			if json.Unmarshal([]byte(line), &record) != nil {
+				continue
+			}
+			value = record.Time
+		}
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil && t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+// needsRotation reports whether writing n more bytes to a file requires a rotation
+func (fs *FileSinkConsumer) needsRotation(f *sinkFile, n int64) bool {
+	if f.size == 0 {
+		return false
+	}
+	if fs.opts.MaxSize > 0 && f.size+n > fs.opts.MaxSize {
+		return true
+	}
+	return fs.opts.MaxAge > 0 && time.Since(f.opened) >= fs.opts.MaxAge
+}
+
+// rotate closes a file and renames it with a timestamp suffix, then gzips it and applies the
+// retention limit in the background (caller holds filesMu)
+func (fs *FileSinkConsumer) rotate(f *sinkFile) {
+	f.file.Close()
+	rotated := rotatedPath(f.path, time.Now())
+	if err := os.Rename(f.path, rotated); err != nil {
+		return
+	}
+
+	fs.compressWg.Add(1)
+	safeGo("filesink-compress", func() {
+		defer fs.compressWg.Done()
+		// On failure the rotated file stays uncompressed (no output: the TUI owns the terminal)
+		_ = gzipFile(rotated)
+		pruneRotatedFiles(f.path, fs.opts.MaxFiles)
+	})
+}
+
+// rotatedPath returns the rotated name of path at now, moved forward by a millisecond while
+// taken: a second rotation in the same millisecond must not replace a file not gzipped yet
+func rotatedPath(path string, now time.Time) string {
+	for {
+		rotated := path + "." + now.Format(fileSinkRotateFormat)
+		_, errPlain := os.Lstat(rotated)
+		_, errGzip := os.Lstat(rotated + ".gz")
+		if os.IsNotExist(errPlain) && os.IsNotExist(errGzip) {
+			return rotated
+		}
+		now = now.Add(time.Millisecond)
+	}
+}
+
+// gzipFile compresses path to path.gz and removes path
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err := io.Copy(zw, src); err != nil {
+		zw.Close()
+		dst.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		dst.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+	return os.Remove(path)
+}
+
+// pruneRotatedFiles removes the oldest rotated files of a log file beyond maxFiles
+func pruneRotatedFiles(path string, maxFiles int) {
+	if maxFiles <= 0 {
+		return
+	}
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return
+	}
+
+	// <name>.<timestamp>.gz (the timestamp check skips files of a container named "<name>.x")
+	prefix := filepath.Base(path) + "."
+	rotated := []string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ".gz") {
+			continue
+		}
+		suffix := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ".gz")
+		if _, err := time.Parse(fileSinkRotateFormat, suffix); err == nil {
+			rotated = append(rotated, name)
+		}
+	}
+	if len(rotated) <= maxFiles {
+		return
+	}
+	sort.Strings(rotated) // Timestamp suffix: oldest first
+	for _, name := range rotated[:len(rotated)-maxFiles] {
+		os.Remove(filepath.Join(filepath.Dir(path), name))
+	}
+}
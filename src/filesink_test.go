@@ -0,0 +1,229 @@
+package main
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestFileSinkPerContainer tests one file per container with time, stream and line
+func TestFileSinkPerContainer(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSinkConsumer(FileSinkOptions{Dir: dir})
+	if err != nil {
+		t.Fatalf("NewFileSinkConsumer: %v", err)
+	}
+
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)
+	sink.OnLogLine("id1", "api", "started", streamStdout, ts)
+	sink.OnLogLine("id1", "api", "connection refused", streamStderr, ts.Add(time.Second))
+	sink.OnLogLine("id2", "db/primary", "ready", streamStdout, ts)
+
+	// Stopped container: file closed, reopened in append mode on restart
+	sink.OnContainerStatusChange("id1", false)
+	sink.OnLogLine("id1", "api", "restarted", streamStdout, ts.Add(time.Minute))
+	sink.Close()
+	sink.OnLogLine("id1", "api", "after close", streamStdout, ts)
+
+	data, err := os.ReadFile(filepath.Join(dir, "api.log"))
+	if err != nil {
+		t.Fatalf("Read api.log: %v", err)
+	}
+	want := "2025-03-01T10:20:30Z stdout started\n" +
+		"2025-03-01T10:20:31Z stderr connection refused\n" +
+		"2025-03-01T10:21:30Z stdout restarted\n"
+	if string(data) != want {
+		t.Errorf("api.log = %q, want %q", data, want)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "db_primary.log")); err != nil {
+		t.Errorf("Expected sanitized file name db_primary.log: %v", err)
+	}
+}
+
+// TestFileSinkCombined tests the combined JSONL file
+func TestFileSinkCombined(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSinkConsumer(FileSinkOptions{Dir: dir, Combined: true})
+	if err != nil {
+		t.Fatalf("NewFileSinkConsumer: %v", err)
+	}
+
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)
+	sink.OnLogLine("id1", "api", `{"level":"error"}`, streamStderr, ts)
+	sink.OnLogLine("id2", "db", "ready", streamStdout, ts)
+	sink.OnContainerStatusChange("id1", false) // Combined file stays open
+	sink.OnLogLine("id2", "db", "checkpoint", streamStdout, ts)
+	sink.Close()
+
+	data, err := os.ReadFile(filepath.Join(dir, fileSinkCombinedName))
+	if err != nil {
+		t.Fatalf("Read combined file: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 records, got %d: %q", len(lines), data)
+	}
+
+	var record sinkRecord
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("Invalid JSON record: %v", err)
+	}
+	want := sinkRecord{Time: "2025-03-01T10:20:30Z", ContainerID: "id1", Container: "api", Stream: streamStderr, Line: `{"level":"error"}`}
+	if record != want {
+		t.Errorf("Record = %+v, want %+v", record, want)
+	}
+}
+
+// TestFileSinkResume tests that the tail replayed on a new run is not persisted twice
+func TestFileSinkResume(t *testing.T) {
+	for _, combined := range []bool{false, true} {
+		dir := t.TempDir()
+		ts := time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)
+
+		first, err := NewFileSinkConsumer(FileSinkOptions{Dir: dir, Combined: combined})
+		if err != nil {
+			t.Fatalf("NewFileSinkConsumer: %v", err)
+		}
+		first.OnLogLine("id1", "api", "one", streamStdout, ts)
+		first.OnLogLine("id1", "api", "two", streamStdout, ts.Add(time.Second))
+		first.Close()
+
+		// Next run: LogBroker replays the tail, then new lines follow
+		second, _ := NewFileSinkConsumer(FileSinkOptions{Dir: dir, Combined: combined})
+		second.OnLogLine("id1", "api", "one", streamStdout, ts)
+		second.OnLogLine("id1", "api", "two", streamStdout, ts.Add(time.Second))
+		second.OnLogLine("id1", "api", "three", streamStdout, ts.Add(2*time.Second))
+		second.Close()
+
+		name := "api.log"
+		if combined {
+			name = fileSinkCombinedName
+		}
+		data, _ := os.ReadFile(filepath.Join(dir, name))
+		for _, line := range []string{"one", "two", "three"} {
+			if n := strings.Count(string(data), line); n != 1 {
+				t.Errorf("combined=%v: %q persisted %d times in %q", combined, line, n, data)
+			}
+		}
+	}
+}
+
+// TestFileSinkRotation tests size rotation, gzip of rotated files and retention
+func TestFileSinkRotation(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSinkConsumer(FileSinkOptions{Dir: dir, MaxSize: 100, MaxFiles: 2})
+	if err != nil {
+		t.Fatalf("NewFileSinkConsumer: %v", err)
+	}
+
+	line := strings.Repeat("x", 60) // One line per file (~90 bytes with time and stream)
+	for i := 0; i < 5; i++ {
+		sink.OnLogLine("id1", "api", line, streamStdout, time.Now())
+		time.Sleep(2 * time.Millisecond) // Distinct rotation suffixes
+	}
+	sink.Close()
+
+	rotated, _ := filepath.Glob(filepath.Join(dir, "api.log.*.gz"))
+	if len(rotated) != 2 {
+		t.Fatalf("Expected 2 rotated files after retention, got %v", rotated)
+	}
+	if uncompressed, _ := filepath.Glob(filepath.Join(dir, "api.log.*[0-9]")); len(uncompressed) != 0 {
+		t.Errorf("Expected rotated files to be compressed, got %v", uncompressed)
+	}
+
+	f, err := os.Open(rotated[0])
+	if err != nil {
+		t.Fatalf("Open rotated file: %v", err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("Rotated file is not gzip: %v", err)
+	}
+	data, _ := io.ReadAll(zr)
+	if !strings.HasSuffix(string(data), " stdout "+line+"\n") {
+		t.Errorf("Unexpected rotated content %q", data)
+	}
+
+	current, _ := os.ReadFile(filepath.Join(dir, "api.log"))
+	if strings.Count(string(current), "\n") != 1 {
+		t.Errorf("Expected the last line in api.log, got %q", current)
+	}
+}
+
+// TestFileSinkTimeRotation tests rotation of files opened longer than MaxAge
+func TestFileSinkTimeRotation(t *testing.T) {
+	dir := t.TempDir()
+	sink, err := NewFileSinkConsumer(FileSinkOptions{Dir: dir, MaxAge: time.Hour})
+	if err != nil {
+		t.Fatalf("NewFileSinkConsumer: %v", err)
+	}
+
+	sink.OnLogLine("id1", "api", "first", streamStdout, time.Now())
+	sink.OnLogLine("id1", "api", "second", streamStdout, time.Now())
+
+	sink.filesMu.Lock()
+	sink.files["id1"].opened = time.Now().Add(-2 * time.Hour)
+	sink.filesMu.Unlock()
+
+	sink.OnLogLine("id1", "api", "third", streamStdout, time.Now())
+	sink.Close()
+
+	rotated, _ := filepath.Glob(filepath.Join(dir, "api.log.*.gz"))
+	if len(rotated) != 1 {
+		t.Fatalf("Expected 1 rotated file, got %v", rotated)
+	}
+	current, _ := os.ReadFile(filepath.Join(dir, "api.log"))
+	if !strings.HasSuffix(string(current), " stdout third\n") || strings.Count(string(current), "\n") != 1 {
+		t.Errorf("Expected only the third line in api.log, got %q", current)
+	}
+}
+
+// TestRotatedPath tests that rotations in the same millisecond get distinct names
+func TestRotatedPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api.log")
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	first := rotatedPath(path, now)
+	if first != path+".20250301-100000.000" {
+		t.Fatalf("rotatedPath = %s", first)
+	}
+	os.WriteFile(first, nil, 0o644)
+	os.WriteFile(path+".20250301-100000.001.gz", nil, 0o644)
+
+	// Not gzipped yet, then already gzipped: both names are taken
+	if got := rotatedPath(path, now); got != path+".20250301-100000.002" {
+		t.Errorf("rotatedPath = %s, want the .002 suffix", got)
+	}
+}
+
+// TestPruneRotatedFiles tests that retention ignores files of other containers
+func TestPruneRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	names := []string{
+		"api.log.20250301-100000.000.gz",
+		"api.log.20250301-110000.000.gz",
+		"api.log.20250301-120000.000.gz",
+		"api.log.log.20250301-090000.000.gz", // Container named "api.log"
+	}
+	for _, name := range names {
+		os.WriteFile(filepath.Join(dir, name), nil, 0o644)
+	}
+
+	pruneRotatedFiles(filepath.Join(dir, "api.log"), 1)
+
+	for i, name := range names {
+		_, err := os.Stat(filepath.Join(dir, name))
+		kept := err == nil
+		if wantKept := i >= 2; kept != wantKept {
+			t.Errorf("%s kept = %v, want %v", name, kept, wantKept)
+		}
+	}
+}
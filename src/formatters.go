@@ -196,6 +196,32 @@ func (m *model) formatPorts(ports []types.Port) string {
 	return strings.Join(result, ",")
 }
 
+// formatBytes formats a byte count with binary units (e.g. "512B", "1.5K", "2.0G")
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit && exp < 5; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%c", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
+// truncateText shortens s to width runes, ending with "..." when cut
+func truncateText(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 3 {
+		return string(runes[:max(0, width)])
+	}
+	return string(runes[:width-3]) + "..."
+}
+
 // Helper functions
 func max(a, b int) int {
 	if a > b {
@@ -229,6 +255,11 @@ func (m *model) formatCPU(containerID string, state string) string {
 		return redStyle.Render(fmt.Sprintf("%7s", "N/A"))
 	}
 
+	return formatCPUValue(cpuPercent)
+}
+
+// formatCPUValue formats a CPU percentage to 7 chars, colored by usage
+func formatCPUValue(cpuPercent float64) string {
 	// Format: 12.3% (right-aligned for better number alignment)
 	cpuText := fmt.Sprintf("%6.1f%%", cpuPercent)
 
@@ -245,6 +276,101 @@ func (m *model) formatCPU(containerID string, state string) string {
 	return style.Render(cpuText)
 }
 
+// formatMemory formats memory usage (page cache excluded), colored by percentage of the limit
+func (m *model) formatMemory(containerID string, state string) string {
+	if state != "running" {
+		return fmt.Sprintf("%7s", "")
+	}
+
+	m.cpuStatsMu.RLock()
+	stats, ok := m.statsCurrent[containerID]
+	m.cpuStatsMu.RUnlock()
+
+	if !ok {
+		return fmt.Sprintf("%7s", "-")
+	}
+
+	memText := fmt.Sprintf("%7s", formatBytes(stats.MemUsage))
+
+	var style lipgloss.Style
+	if stats.MemPercent < 70 {
+		style = lipgloss.NewStyle().Foreground(lipgloss.Color("#4ec9b0")) // Teal (low)
+	} else if stats.MemPercent < 90 {
+		style = lipgloss.NewStyle().Foreground(lipgloss.Color("#dcdcaa")) // Yellow (close to limit)
+	} else {
+		style = lipgloss.NewStyle().Foreground(lipgloss.Color("#f48771")) // Red (about to be OOM killed)
+	}
+
+	return style.Render(memText)
+}
+
+// formatNetIO formats network receive/transmit rates (bytes per second)
+func (m *model) formatNetIO(containerID string, state string) string {
+	if state != "running" {
+		return fmt.Sprintf("%12s", "")
+	}
+
+	m.cpuStatsMu.RLock()
+	stats, ok := m.statsCurrent[containerID]
+	m.cpuStatsMu.RUnlock()
+
+	if !ok {
+		return fmt.Sprintf("%12s", "-")
+	}
+	return fmt.Sprintf("%5s↓ %5s↑", formatCompactBytes(stats.NetRxRate), formatCompactBytes(stats.NetTxRate))
+}
+
+// formatBlockIO formats block device read/write rates (bytes per second)
+func (m *model) formatBlockIO(containerID string, state string) string {
+	if state != "running" {
+		return fmt.Sprintf("%12s", "")
+	}
+
+	m.cpuStatsMu.RLock()
+	stats, ok := m.statsCurrent[containerID]
+	m.cpuStatsMu.RUnlock()
+
+	if !ok {
+		return fmt.Sprintf("%12s", "-")
+	}
+	return fmt.Sprintf("%5sr %5sw", formatCompactBytes(stats.BlockReadRate), formatCompactBytes(stats.BlockWriteRate))
+}
+
+// formatPIDs formats the number of processes in the container
+func (m *model) formatPIDs(containerID string, state string) string {
+	if state != "running" {
+		return fmt.Sprintf("%4s", "")
+	}
+
+	m.cpuStatsMu.RLock()
+	stats, ok := m.statsCurrent[containerID]
+	m.cpuStatsMu.RUnlock()
+
+	if !ok {
+		return fmt.Sprintf("%4s", "-")
+	}
+	return fmt.Sprintf("%4d", stats.PIDs)
+}
+
+// formatCompactBytes formats a byte count in at most 5 characters (e.g. "0", "512B", "1.5K", "120M")
+func formatCompactBytes(v float64) string {
+	if v < 1 {
+		return "0"
+	}
+	if v < 1024 {
+		return fmt.Sprintf("%.0fB", v)
+	}
+	units := "KMGTPE"
+	exp := 0
+	for v /= 1024; v >= 1024 && exp < len(units)-1; v /= 1024 {
+		exp++
+	}
+	if v < 10 {
+		return fmt.Sprintf("%.1f%c", v, units[exp])
+	}
+	return fmt.Sprintf("%.0f%c", v, units[exp])
+}
+
 // formatLogRate formats log rate (lines per second)
 func (m *model) formatLogRate(containerID string, state string) string {
 	// Only show logs rate for running containers
@@ -257,8 +383,11 @@ func (m *model) formatLogRate(containerID string, state string) string {
 		return fmt.Sprintf("%6s", "0")
 	}
 
-	rate := m.rateTracker.GetRate(containerID)
+	return formatLogRateValue(m.rateTracker.GetRate(containerID))
+}
 
+// formatLogRateValue formats a log rate (lines per second) to 6 colored chars
+func formatLogRateValue(rate float64) string {
 	// Cap at 9999 l/s maximum display
 	if rate > 9999 {
 		rate = 9999
@@ -302,3 +431,41 @@ func (m *model) formatLogRate(containerID string, state string) string {
 
 	return style.Render(formatted)
 }
+
+// formatErrorRate formats the error rate (errors in the last minute) and its 5-minute sparkline
+func (m *model) formatErrorRate(containerID string, state string) string {
+	// Only show error rate for running containers
+	if state != "running" {
+		return fmt.Sprintf("%16s", "")
+	}
+
+	if m.rateTracker == nil {
+		return fmt.Sprintf("%5s %10s", "0", "")
+	}
+
+	return formatErrorStats(m.rateTracker.GetErrorStats(containerID))
+}
+
+// formatErrorStats formats error stats to 16 chars: errors/min (5) + space + sparkline (10)
+func formatErrorStats(stats ErrorStats) string {
+	sparkline := lipgloss.NewStyle().Foreground(lipgloss.Color("#ff5f5f")).Render(formatSparkline(stats.History))
+	return formatErrorRateValue(stats.PerMinute1m) + " " + sparkline
+}
+
+// formatErrorRateValue formats an error rate (errors per minute) to 5 colored chars
+func formatErrorRateValue(rate float64) string {
+	// Cap at 9999 errors/min maximum display
+	if rate > 9999 {
+		rate = 9999
+	}
+
+	// Pad to exactly 5 characters BEFORE applying color (right-aligned)
+	formatted := fmt.Sprintf("%5.0f", rate)
+
+	if rate == 0 {
+		// Gray when no errors
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(formatted)
+	}
+	// Red: the container is producing errors
+	return lipgloss.NewStyle().Foreground(lipgloss.Color("#ff5f5f")).Bold(true).Render(formatted)
+}
@@ -42,7 +42,7 @@ func TestModel_FormatLogRate_HighRate(t *testing.T) {
 
 	// Add many log lines to simulate high rate via OnLogLine
 	for i := 0; i < 15000; i++ {
-		m.rateTracker.OnLogLine("c1", "container1", "log line", time.Now())
+		m.rateTracker.OnLogLine("c1", "container1", "log line", streamStdout, time.Now())
 	}
 
 	result := m.formatLogRate("c1", "running")
@@ -464,3 +464,72 @@ func TestStripAnsiCodes(t *testing.T) {
 		})
 	}
 }
+
+// TestFormatBytes tests human-readable byte formatting
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		input uint64
+		want  string
+	}{
+		{0, "0B"},
+		{512, "512B"},
+		{1024, "1.0K"},
+		{1536, "1.5K"},
+		{512 * 1024 * 1024, "512.0M"},
+		{2 * 1024 * 1024 * 1024, "2.0G"},
+	}
+
+	for _, tt := range tests {
+		if got := formatBytes(tt.input); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+// TestFormatCompactBytes tests 5-character byte formatting used by the I/O columns
+func TestFormatCompactBytes(t *testing.T) {
+	tests := []struct {
+		input float64
+		want  string
+	}{
+		{0, "0"},
+		{0.4, "0"},
+		{512, "512B"},
+		{1536, "1.5K"},
+		{150 * 1024, "150K"},
+		{1023.9 * 1024, "1024K"},
+		{3 * 1024 * 1024, "3.0M"},
+	}
+
+	for _, tt := range tests {
+		got := formatCompactBytes(tt.input)
+		if got != tt.want {
+			t.Errorf("formatCompactBytes(%v) = %q, want %q", tt.input, got, tt.want)
+		}
+		if len(got) > 5 {
+			t.Errorf("formatCompactBytes(%v) = %q exceeds 5 characters", tt.input, got)
+		}
+	}
+}
+
+// TestFormatMemory tests the MEM column
+func TestFormatMemory(t *testing.T) {
+	m := &model{
+		statsCurrent: map[string]ContainerStats{
+			"c1": {MemUsage: 512 * 1024 * 1024, MemLimit: 1024 * 1024 * 1024, MemPercent: 50},
+		},
+	}
+
+	if got := stripAnsiCodes(m.formatMemory("c1", "running")); got != " 512.0M" {
+		t.Errorf("formatMemory() = %q, want %q", got, " 512.0M")
+	}
+	if got := m.formatMemory("c1", "exited"); strings.TrimSpace(got) != "" {
+		t.Errorf("Expected blank memory for stopped container, got %q", got)
+	}
+	if got := m.formatMemory("unknown", "running"); strings.TrimSpace(got) != "-" {
+		t.Errorf("Expected '-' for container without stats, got %q", got)
+	}
+	if got := m.formatPIDs("unknown", "running"); len(got) != 4 {
+		t.Errorf("Expected 4-char PIDs column, got %q", got)
+	}
+}
@@ -11,6 +11,10 @@ import (
 // Individual view logic is in:
 //   - handlers_filter.go  (filter mode)
 //   - handlers_logs.go    (logs view)
+//   - handlers_inspect.go (inspect view)
+//   - handlers_images.go  (images view)
+//   - handlers_volumes.go (volumes view)
+//   - handlers_networks.go (networks view)
 //   - handlers_list.go    (list view)
 //   - handlers_confirm.go (confirmation dialogs)
 //   - handlers_mouse.go   (mouse events)
@@ -37,6 +41,71 @@ func (m *model) showActionConfirmation(action string, selected []string) {
 	m.view = confirmView
 }
 
+// showViewConfirmation opens confirmView for an action of a non-container view
+// (images, volumes, networks); confirming calls back into that view via confirmReturnView
+func (m *model) showViewConfirmation(returnView viewMode, action string, targets []string, message string) {
+	m.confirmMessage = message
+	m.pendingAction = action
+	m.pendingTargets = targets
+	m.confirmReturnView = returnView
+	m.view = confirmView
+}
+
+// resourceViews is the TAB cycle order of the list view and the resource views
+var resourceViews = []viewMode{listView, imagesView, volumesView, networksView}
+
+// cycleView switches to the next (TAB) or previous (Shift+TAB) view of resourceViews
+func (m *model) cycleView(forward bool) tea.Cmd {
+	idx := 0
+	for i, v := range resourceViews {
+		if v == m.view {
+			idx = i
+			break
+		}
+	}
+	step := 1
+	if !forward {
+		step = len(resourceViews) - 1
+	}
+
+	switch resourceViews[(idx+step)%len(resourceViews)] {
+	case imagesView:
+		return m.openImagesView()
+	case volumesView:
+		return m.openVolumesView()
+	case networksView:
+		return m.openNetworksView()
+	}
+	m.view = listView
+	return nil
+}
+
+// moveRowCursor applies the navigation keys shared by the resource views to cursor
+// Returns false when key is not a navigation key.
+func moveRowCursor(key string, cursor *int, count int) bool {
+	switch key {
+	case "up":
+		if *cursor > 0 {
+			*cursor--
+		}
+	case "down":
+		if *cursor < count-1 {
+			*cursor++
+		}
+	case "pgup":
+		*cursor = max(0, *cursor-10)
+	case "pgdown":
+		*cursor = max(0, min(count-1, *cursor+10))
+	case "home":
+		*cursor = 0
+	case "end":
+		*cursor = max(0, count-1)
+	default:
+		return false
+	}
+	return true
+}
+
 func (m *model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Handle filter mode first (intercept all keys)
 	if m.filterMode {
@@ -56,6 +125,18 @@ func (m *model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case mcpLogsView:
 		return m.handleMCPLogsViewKeys(msg)
 
+	case inspectView:
+		return m.handleInspectViewKeys(msg)
+
+	case imagesView:
+		return m.handleImagesViewKeys(msg)
+
+	case volumesView:
+		return m.handleVolumesViewKeys(msg)
+
+	case networksView:
+		return m.handleNetworksViewKeys(msg)
+
 	case listView:
 		return m.handleListViewKeys(msg)
 	}
@@ -18,16 +18,31 @@ func (m *model) handleExitConfirmKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m *model) handleConfirmViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "y", "Y":
-		m.view = listView
+		returnView := m.confirmReturnView
+		m.view = returnView
+		m.confirmReturnView = listView
 		action := m.pendingAction
+		targets := m.pendingTargets
 		m.pendingAction = ""
-		if action != "" {
-			return m, m.performAction(action)
+		m.pendingTargets = nil
+		if action == "" {
+			return m, nil
 		}
-		return m, nil
+		switch returnView {
+		case imagesView:
+			return m, m.performImageAction(action, targets)
+		case volumesView:
+			return m, m.performVolumeAction(action, targets)
+		case networksView:
+			return m, m.performNetworkAction(action, targets)
+		}
+		return m, m.performAction(action)
 	case "n", "N", "esc", "q", "Q":
-		m.view = listView
+		m.view = m.confirmReturnView
+		m.confirmReturnView = listView
 		m.pendingAction = ""
+		m.pendingTargets = nil
+		m.pendingNetworkID = ""
 		return m, nil
 	}
 	return m, nil
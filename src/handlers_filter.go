@@ -19,6 +19,13 @@ func (m *model) handleFilterMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.filterInput = m.filterActive
 		return m, nil
 
+	case tea.KeyCtrlR:
+		// Toggle regex mode of the logs filter (list filter is always a regex)
+		if m.view == logsView {
+			m.toggleLogsFilterRegex()
+		}
+		return m, nil
+
 	case tea.KeyBackspace:
 		// Remove last character
 		if len(m.filterInput) > 0 {
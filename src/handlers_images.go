@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openImagesView switches to the images view and (re)loads the image list
+func (m *model) openImagesView() tea.Cmd {
+	m.view = imagesView
+	m.imagesLoading = true
+	if m.imagesSelected == nil {
+		m.imagesSelected = make(map[string]bool)
+	}
+	return loadImages(m.dockerClient)
+}
+
+// handleImagesViewKeys handles keyboard input in images view
+func (m *model) handleImagesViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Pull prompt intercepts all keys while typing
+	if m.pullPromptMode {
+		return m.handlePullPrompt(msg)
+	}
+
+	if moveRowCursor(msg.String(), &m.imagesCursor, len(m.imageRows)) {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q", "Q":
+		m.view = listView
+		return m, nil
+
+	case "tab":
+		return m, m.cycleView(true)
+	case "shift+tab":
+		return m, m.cycleView(false)
+
+	case " ":
+		if m.imagesCursor >= 0 && m.imagesCursor < len(m.imageRows) {
+			ref := m.imageRows[m.imagesCursor].ref()
+			m.imagesSelected[ref] = !m.imagesSelected[ref]
+		}
+	case "a", "A":
+		m.imagesSelected = make(map[string]bool)
+		for _, row := range m.imageRows {
+			m.imagesSelected[row.ref()] = true
+		}
+	case "x", "X":
+		m.imagesSelected = make(map[string]bool)
+	case "i", "I":
+		for _, row := range m.imageRows {
+			m.imagesSelected[row.ref()] = !m.imagesSelected[row.ref()]
+		}
+
+	case "r", "R":
+		m.imagesLoading = true
+		return m, loadImages(m.dockerClient)
+
+	case "d", "D":
+		refs := m.selectedImageRefs()
+		if len(refs) > 0 {
+			m.showViewConfirmation(imagesView, "remove-images", refs,
+				fmt.Sprintf("Remove %d image(s)?\n\n%s\n\nTagged images are untagged, the image is deleted with its last tag.\n\nPress Y to confirm, N to cancel",
+					len(refs), strings.Join(refs, "\n")))
+		}
+
+	case "p", "P":
+		m.showViewConfirmation(imagesView, "prune-images", nil,
+			"Prune dangling images?\n\nAll untagged images not used by a container will be deleted.\n\n⚠ This action cannot be undone!\n\nPress Y to confirm, N to cancel")
+
+	case "u", "U":
+		if m.imagePull != nil {
+			return m, func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("pull: %s already in progress", m.imagePull.ref), isError: true}
+			}
+		}
+		// Pre-fill with the cursor image so that U + ENTER re-pulls it
+		m.pullPromptMode = true
+		m.pullPromptInput = ""
+		if m.imagesCursor >= 0 && m.imagesCursor < len(m.imageRows) {
+			if row := m.imageRows[m.imagesCursor]; row.tag != "<none>" {
+				m.pullPromptInput = row.ref()
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// handlePullPrompt handles keyboard input while typing the reference to pull
+func (m *model) handlePullPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.pullPromptMode = false
+		m.pullPromptInput = ""
+		return m, nil
+	case tea.KeyEnter:
+		ref := strings.TrimSpace(m.pullPromptInput)
+		m.pullPromptMode = false
+		m.pullPromptInput = ""
+		if ref == "" {
+			return m, nil
+		}
+		m.imagePull = newImagePullState(ref)
+		return m, tea.Batch(
+			pullImage(m.dockerClient, m.imagePull),
+			waitForPullProgress(m.imagePull.updates),
+		)
+	case tea.KeyBackspace:
+		if len(m.pullPromptInput) > 0 {
+			runes := []rune(m.pullPromptInput)
+			m.pullPromptInput = string(runes[:len(runes)-1])
+		}
+		return m, nil
+	case tea.KeyRunes:
+		m.pullPromptInput += string(msg.Runes)
+		return m, nil
+	}
+	return m, nil
+}
+
+// performImageAction runs a confirmed images view action
+func (m *model) performImageAction(action string, targets []string) tea.Cmd {
+	switch action {
+	case "remove-images":
+		// Removed refs are no longer selectable
+		for _, ref := range targets {
+			delete(m.imagesSelected, ref)
+		}
+		return removeImages(m.dockerClient, targets)
+	case "prune-images":
+		return pruneImages(m.dockerClient)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package main
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// handleInspectViewKeys handles keyboard input in inspect view
+func (m *model) handleInspectViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "Q":
+		// Return to list view (collapsed sections are kept for the next container)
+		m.view = listView
+		m.inspectSections = nil
+		m.inspectErr = nil
+		m.inspectLoading = false
+		return m, nil
+	case "up", "k":
+		m.moveInspectCursor(-1)
+		return m, nil
+	case "down", "j":
+		m.moveInspectCursor(1)
+		return m, nil
+	case "pgup":
+		m.moveInspectCursor(-10)
+		return m, nil
+	case "pgdown":
+		m.moveInspectCursor(10)
+		return m, nil
+	case "home":
+		m.moveInspectCursor(-len(m.inspectRows()))
+		return m, nil
+	case "end":
+		m.moveInspectCursor(len(m.inspectRows()))
+		return m, nil
+	case "enter", " ":
+		// Collapse/expand the section under the cursor
+		m.toggleInspectSection()
+		return m, nil
+	case "+":
+		m.setAllInspectSections(false)
+		return m, nil
+	case "-":
+		m.setAllInspectSections(true)
+		return m, nil
+	case "r", "R":
+		// Refresh (state, health log and IPs change while the view is open)
+		if m.inspectLoading {
+			return m, nil
+		}
+		m.inspectLoading = true
+		return m, fetchInspect(m.dockerClient, m.inspectContainerID)
+	}
+
+	return m, nil
+}
@@ -13,10 +13,9 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	// Handle Shift+Up/Down for range selection FIRST
 	case "shift+up":
-		// CRITICAL FIX: Protect read of m.containers during range selection
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		if m.cursor > 0 && containersLen > 0 {
+		// Rows are containers, or compose project headers in grouped mode
+		rowCount := m.listRowCount()
+		if m.cursor > 0 && rowCount > 0 {
 			// Start shift selection if not already started
 			if m.shiftStart == -1 {
 				m.shiftStart = m.cursor
@@ -27,14 +26,8 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			start := min(m.shiftStart, m.cursor)
 			end := max(m.shiftStart, m.cursor)
 
-			// Collect IDs to select (while holding containers lock)
-			idsToSelect := make([]string, 0, end-start+1)
-			for i := start; i <= end; i++ {
-				if i >= 0 && i < containersLen {
-					idsToSelect = append(idsToSelect, m.containers[i].ID)
-				}
-			}
-			m.containersMu.RUnlock()
+			// Collect IDs to select (headers select their whole project)
+			idsToSelect := m.rangeContainerIDs(start, end)
 
 			// Now update selection map (separate lock)
 			m.selectedMu.Lock()
@@ -43,16 +36,13 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.selected[id] = true
 			}
 			m.selectedMu.Unlock()
-		} else {
-			m.containersMu.RUnlock()
 		}
 		return m, nil
 
 	case "shift+down":
-		// CRITICAL FIX: Protect read of m.containers during range selection
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		if m.cursor < containersLen-1 && containersLen > 0 {
+		// Rows are containers, or compose project headers in grouped mode
+		rowCount := m.listRowCount()
+		if m.cursor < rowCount-1 && rowCount > 0 {
 			// Start shift selection if not already started
 			if m.shiftStart == -1 {
 				m.shiftStart = m.cursor
@@ -63,14 +53,8 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			start := min(m.shiftStart, m.cursor)
 			end := max(m.shiftStart, m.cursor)
 
-			// Collect IDs to select (while holding containers lock)
-			idsToSelect := make([]string, 0, end-start+1)
-			for i := start; i <= end; i++ {
-				if i >= 0 && i < containersLen {
-					idsToSelect = append(idsToSelect, m.containers[i].ID)
-				}
-			}
-			m.containersMu.RUnlock()
+			// Collect IDs to select (headers select their whole project)
+			idsToSelect := m.rangeContainerIDs(start, end)
 
 			// Now update selection map (separate lock)
 			m.selectedMu.Lock()
@@ -79,8 +63,6 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				m.selected[id] = true
 			}
 			m.selectedMu.Unlock()
-		} else {
-			m.containersMu.RUnlock()
 		}
 		return m, nil
 
@@ -109,10 +91,7 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 
 	case "down":
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		m.containersMu.RUnlock()
-		if m.cursor < containersLen-1 {
+		if m.cursor < m.listRowCount()-1 {
 			m.cursor++
 			m.shiftStart = -1
 		}
@@ -122,10 +101,7 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.shiftStart = -1
 
 	case "pgdown":
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		m.containersMu.RUnlock()
-		m.cursor = min(containersLen-1, m.cursor+10)
+		m.cursor = max(0, min(m.listRowCount()-1, m.cursor+10))
 		m.shiftStart = -1
 
 	case "home":
@@ -133,29 +109,14 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.shiftStart = -1
 
 	case "end":
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		m.containersMu.RUnlock()
-		if containersLen > 0 {
-			m.cursor = containersLen - 1
+		if rowCount := m.listRowCount(); rowCount > 0 {
+			m.cursor = rowCount - 1
 		}
 		m.shiftStart = -1
 
 	case " ":
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		var containerID string
-		if m.cursor >= 0 && m.cursor < containersLen {
-			containerID = m.containers[m.cursor].ID
-		}
-		m.containersMu.RUnlock()
-
-		if containerID != "" {
-			// CRITICAL FIX: Protect concurrent map write
-			m.selectedMu.Lock()
-			m.selected[containerID] = !m.selected[containerID]
-			m.selectedMu.Unlock()
-		}
+		// On a compose project header, selects (or deselects) the whole project
+		m.toggleRowSelection(m.cursor)
 
 	case "x", "X":
 		// CRITICAL FIX: Protect concurrent map write
@@ -270,8 +231,12 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				}
 			}, &m.logChanClosing, &m.logChanWg)
 
+			// Register consumer BEFORE fetching the tails: lines arriving meanwhile are kept,
+			// PreloadEntries merges them by time and drops those already in a tail
+			m.logBroker.RegisterConsumer(m.bufferConsumer)
+
 			// Pre-load existing logs
-			recentLogs := m.logBroker.FetchRecentLogs(selected, "100")
+			recentLogs := m.logBroker.FetchRecentEntries(selected, "100")
 			containerNames := make(map[string]string)
 			// CRITICAL FIX: Protect read of m.containers
 			m.containersMu.RLock()
@@ -285,10 +250,7 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			m.containersMu.RUnlock()
 			// Pass selected IDs to maintain stable ordering
-			m.bufferConsumer.PreloadLogs(selected, recentLogs, containerNames)
-
-			// Register consumer AFTER preloading to avoid duplicates
-			m.logBroker.RegisterConsumer(m.bufferConsumer)
+			m.bufferConsumer.PreloadEntries(selected, recentLogs, containerNames)
 
 			// Auto-scroll to bottom
 			m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
@@ -335,6 +297,50 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, m.performAction("pause")
 
+	case "e", "E":
+		// Open an interactive shell in the selected (or cursor) container
+		id, name, errMsg := m.shellTarget()
+		if errMsg != "" {
+			return m, func() tea.Msg {
+				return toastMsg{message: errMsg, isError: true}
+			}
+		}
+		if id != "" {
+			return m, m.openShell(id, name)
+		}
+
+	case "v", "V":
+		// Open the inspect view for the selected (or cursor) container
+		id, name, errMsg := m.inspectTarget()
+		if errMsg != "" {
+			return m, func() tea.Msg {
+				return toastMsg{message: errMsg, isError: true}
+			}
+		}
+		if id != "" {
+			return m, m.openInspect(id, name)
+		}
+
+	case "left":
+		// Collapse the compose project of the cursor row (grouped mode)
+		m.setProjectCollapsed(true)
+	case "right":
+		m.setProjectCollapsed(false)
+
+	case "g", "G":
+		// Toggle grouping by compose project
+		m.toggleGroupByProject()
+
+	case "h", "H":
+		// Switch to the next Docker host (multi-host)
+		return m, m.switchHost(m.activeHost + 1)
+
+	case "tab":
+		// Switch to the images view (then volumes, networks)
+		return m, m.cycleView(true)
+	case "shift+tab":
+		return m, m.cycleView(false)
+
 	case "m", "M":
 		// Show MCP server logs popup (only if MCP server is running)
 		if m.mcpServer != nil {
@@ -344,13 +350,6 @@ func (m *model) handleListViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "d", "D":
 		selected := m.getSelectedIDs()
-		if len(selected) == 0 {
-			m.containersMu.RLock()
-			if m.cursor >= 0 && m.cursor < len(m.containers) {
-				selected = []string{m.containers[m.cursor].ID}
-			}
-			m.containersMu.RUnlock()
-		}
 		if len(selected) > 0 {
 			names := []string{}
 			// CRITICAL FIX: Protect read of m.containers
@@ -4,6 +4,11 @@ import tea "github.com/charmbracelet/bubbletea"
 
 // handleLogsViewKeys handles keyboard input in logs view
 func (m *model) handleLogsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// Export prompt intercepts all keys while typing
+	if m.logsExportMode {
+		return m.handleLogsExportPrompt(msg)
+	}
+
 	switch msg.String() {
 	case "esc", "q", "Q":
 		// If filter is active, clear it instead of exiting
@@ -12,11 +17,17 @@ func (m *model) handleLogsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.filterInput = ""
 			m.filterRegex = nil
 			m.filterIsRegex = false
+			m.logsMatchKey = nil
 			// Reset scroll to bottom (100%)
 			m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
 			return m, nil
 		}
 
+		// Offline viewer: there is no list to return to
+		if m.viewerFiles != nil {
+			return m, tea.Quit
+		}
+
 		// CRITICAL FIX: Protect entire view transition with mutex to prevent race with concurrent callbacks
 		m.viewTransitionMu.Lock()
 
@@ -44,9 +55,15 @@ func (m *model) handleLogsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.view = listView
 		m.logsViewBuffer = []string{}
 		m.logsViewScroll = 0
+		m.logsMatchKey = nil
 
 		m.viewTransitionMu.Unlock()
 		return m, nil
+	case "ctrl+c":
+		if m.viewerFiles != nil {
+			return m, tea.Quit
+		}
+		return m, nil
 	case "enter":
 		// Insert a visual separator line in the log buffer
 		if m.bufferConsumer != nil {
@@ -101,6 +118,42 @@ func (m *model) handleLogsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Toggle colored backgrounds in logs view
 		m.logsColorEnabled = !m.logsColorEnabled
 		return m, nil
+	case "r", "R":
+		// Toggle regex mode of the logs filter
+		m.toggleLogsFilterRegex()
+		return m, nil
+	case "t", "T":
+		// Cycle timestamp column: off, absolute, relative, delta
+		m.toggleLogsTimestamps()
+		return m, nil
+	case "l", "L":
+		// Cycle minimum level: all, DEBUG, INFO, WARN, ERROR
+		m.toggleLogsMinLevel()
+		return m, nil
+	case "p", "P":
+		// Toggle pretty mode for JSON/logfmt lines
+		m.toggleLogsPretty()
+		return m, nil
+	case "e", "E":
+		// Toggle stderr-only lines
+		m.toggleLogsStderrOnly()
+		return m, nil
+	case "s", "S":
+		// Save the lines shown (filters applied) to a file
+		if m.bufferConsumer != nil {
+			m.startLogsExport()
+		}
+		return m, nil
+	case "h", "H":
+		// Toggle highlight mode (keep all lines, color matches)
+		m.toggleLogsHighlight()
+		return m, nil
+	case "n":
+		m.jumpToLogMatch(true)
+		return m, nil
+	case "N":
+		m.jumpToLogMatch(false)
+		return m, nil
 	}
 
 	return m, nil
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 	"time"
@@ -42,6 +43,49 @@ func (m *model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// Handle mouse wheel in inspect view (moves the cursor, like the arrow keys)
+	if m.view == inspectView {
+		switch msg.Type {
+		case tea.MouseWheelUp:
+			m.moveInspectCursor(-3)
+		case tea.MouseWheelDown:
+			m.moveInspectCursor(3)
+		}
+		return m, nil
+	}
+
+	// Handle mouse wheel in images view
+	if m.view == imagesView {
+		switch msg.Type {
+		case tea.MouseWheelUp:
+			if m.imagesCursor > 0 {
+				m.imagesCursor--
+			}
+		case tea.MouseWheelDown:
+			if m.imagesCursor < len(m.imageRows)-1 {
+				m.imagesCursor++
+			}
+		}
+		return m, nil
+	}
+
+	// Handle mouse wheel in volumes and networks views
+	if m.view == volumesView || m.view == networksView {
+		key := ""
+		switch msg.Type {
+		case tea.MouseWheelUp:
+			key = "up"
+		case tea.MouseWheelDown:
+			key = "down"
+		}
+		if m.view == volumesView {
+			moveRowCursor(key, &m.volumesCursor, len(m.volumeRows))
+		} else {
+			moveRowCursor(key, &m.networksCursor, len(m.networkRows))
+		}
+		return m, nil
+	}
+
 	// Handle mouse in list view
 	if m.view != listView {
 		return m, nil
@@ -63,6 +107,14 @@ func (m *model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 		// Line 5+: containers (inside box)
 		headerOffset := 5
 
+		// Grouped mode: rows are project headers and containers
+		if m.groupByProject {
+			if clickedLine >= headerOffset {
+				return m.handleGroupedClick(clickedLine - headerOffset)
+			}
+			return m, nil
+		}
+
 		if clickedLine >= headerOffset && len(m.containers) > 0 {
 			clickedIndex := clickedLine - headerOffset
 			// CRITICAL FIX: Protect read of m.containers with mutex and bounds check
@@ -86,8 +138,20 @@ func (m *model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 					} else {
 						containerName = containerID[:12] // Fallback to short ID
 					}
+					containerState := m.containers[clickedIndex].State
 					m.containersMu.RUnlock()
 
+					// Double-click opens a shell instead of logs when launched with --double-click shell
+					if m.doubleClickShell {
+						m.cursor = clickedIndex
+						if containerState != "running" {
+							return m, func() tea.Msg {
+								return toastMsg{message: fmt.Sprintf("shell: %s is not running", containerName), isError: true}
+							}
+						}
+						return m, m.openShell(containerID, containerName)
+					}
+
 					// CRITICAL FIX: Protect entire view transition with mutex (double-click path)
 					m.viewTransitionMu.Lock()
 
@@ -128,15 +192,15 @@ func (m *model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 						}
 					}, &m.logChanClosing, &m.logChanWg)
 
+					// Register consumer BEFORE fetching the tail (see handleListViewKeys)
+					m.logBroker.RegisterConsumer(m.bufferConsumer)
+
 					// Pre-load existing logs
-					recentLogs := m.logBroker.FetchRecentLogs([]string{containerID}, "100")
+					recentLogs := m.logBroker.FetchRecentEntries([]string{containerID}, "100")
 					containerNames := make(map[string]string)
 					containerNames[containerID] = containerName
 					// Pass container ID slice to maintain stable ordering
-					m.bufferConsumer.PreloadLogs([]string{containerID}, recentLogs, containerNames)
-
-					// Register consumer AFTER preloading
-					m.logBroker.RegisterConsumer(m.bufferConsumer)
+					m.bufferConsumer.PreloadEntries([]string{containerID}, recentLogs, containerNames)
 
 					// Auto-scroll to bottom
 					m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
@@ -177,11 +241,7 @@ func (m *model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 
 	case tea.MouseWheelDown:
 		// Scroll down
-		// CRITICAL FIX: Protect read of len(m.containers) with mutex
-		m.containersMu.RLock()
-		containersLen := len(m.containers)
-		m.containersMu.RUnlock()
-		if m.cursor < containersLen-1 {
+		if m.cursor < m.listRowCount()-1 {
 			m.cursor++
 			m.shiftStart = -1
 		}
@@ -189,3 +249,50 @@ func (m *model) handleMouseEvent(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
 
 	return m, nil
 }
+
+// handleGroupedClick handles a click on row clickedIndex of the grouped container list
+// Single click moves the cursor and toggles selection (the whole project on a header),
+// double click toggles a project header or opens the clicked container like in ungrouped mode.
+func (m *model) handleGroupedClick(clickedIndex int) (tea.Model, tea.Cmd) {
+	rows := m.listRows()
+	if clickedIndex < 0 || clickedIndex >= len(rows) {
+		return m, nil
+	}
+	row := rows[clickedIndex]
+
+	now := time.Now()
+	isDoubleClick := clickedIndex == m.lastClickIndex &&
+		now.Sub(m.lastClickTime) < 500*time.Millisecond
+	m.lastClickTime = now
+	m.lastClickIndex = clickedIndex
+	m.cursor = clickedIndex
+	m.shiftStart = -1
+
+	if !isDoubleClick {
+		m.toggleRowSelection(clickedIndex)
+		return m, nil
+	}
+
+	if row.header {
+		// The first click of the double click toggled the selection: undo it
+		m.toggleRowSelection(clickedIndex)
+		m.setProjectCollapsed(!m.collapsedProjects[row.project])
+		return m, nil
+	}
+
+	m.selectedMu.Lock()
+	m.selected = map[string]bool{row.container.ID: true}
+	m.selectedMu.Unlock()
+
+	if m.doubleClickShell {
+		name := getContainerName(row.container)
+		if row.container.State != "running" {
+			return m, func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("shell: %s is not running", name), isError: true}
+			}
+		}
+		return m, m.openShell(row.container.ID, name)
+	}
+	// Same as ENTER with the clicked container selected
+	return m.handleListViewKeys(tea.KeyMsg{Type: tea.KeyEnter})
+}
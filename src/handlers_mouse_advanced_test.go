@@ -80,7 +80,7 @@ func TestHandleMouseEvent_LogsView_WheelDown(t *testing.T) {
 
 	// Add 50 logs
 	for i := 0; i < 50; i++ {
-		bc.OnLogLine("c1", "container1", "log line", time.Now())
+		bc.OnLogLine("c1", "container1", "log line", streamStdout, time.Now())
 	}
 
 	m := &model{
@@ -114,7 +114,7 @@ func TestHandleMouseEvent_LogsView_WheelDown_MaxScroll(t *testing.T) {
 
 	// Add 20 logs
 	for i := 0; i < 20; i++ {
-		bc.OnLogLine("c1", "container1", "log line", time.Now())
+		bc.OnLogLine("c1", "container1", "log line", streamStdout, time.Now())
 	}
 
 	m := &model{
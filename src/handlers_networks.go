@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openNetworksView switches to the networks view and (re)loads the network list
+// The container list is reloaded too: attachments are read from it.
+func (m *model) openNetworksView() tea.Cmd {
+	m.view = networksView
+	m.networksLoading = true
+	if m.networksSelected == nil {
+		m.networksSelected = make(map[string]bool)
+	}
+	return tea.Batch(loadNetworks(m.dockerClient), m.reloadContainers())
+}
+
+// handleNetworksViewKeys handles keyboard input in networks view
+func (m *model) handleNetworksViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if moveRowCursor(msg.String(), &m.networksCursor, len(m.networkRows)) {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q", "Q":
+		m.view = listView
+		return m, nil
+
+	case "tab":
+		return m, m.cycleView(true)
+	case "shift+tab":
+		return m, m.cycleView(false)
+
+	case " ":
+		if m.networksCursor >= 0 && m.networksCursor < len(m.networkRows) {
+			id := m.networkRows[m.networksCursor].id
+			m.networksSelected[id] = !m.networksSelected[id]
+		}
+	case "a", "A":
+		m.networksSelected = make(map[string]bool)
+		for _, row := range m.networkRows {
+			m.networksSelected[row.id] = true
+		}
+	case "x", "X":
+		m.networksSelected = make(map[string]bool)
+	case "i", "I":
+		for _, row := range m.networkRows {
+			m.networksSelected[row.id] = !m.networksSelected[row.id]
+		}
+
+	case "r", "R":
+		m.networksLoading = true
+		return m, tea.Batch(loadNetworks(m.dockerClient), m.reloadContainers())
+
+	case "d", "D":
+		ids := m.selectedNetworkIDs()
+		if len(ids) > 0 {
+			names := make([]string, 0, len(ids))
+			for _, id := range ids {
+				names = append(names, m.networkName(id))
+			}
+			m.showViewConfirmation(networksView, "remove-networks", ids,
+				fmt.Sprintf("Remove %d network(s)?\n\n%s\n\nPress Y to confirm, N to cancel",
+					len(ids), strings.Join(names, "\n")))
+		}
+
+	case "p", "P":
+		m.showViewConfirmation(networksView, "prune-networks", nil,
+			"Prune unused networks?\n\nAll custom networks not used by any container will be deleted.\n\nPress Y to confirm, N to cancel")
+
+	case "c", "C":
+		return m, m.confirmNetworkConnect(true)
+	case "u", "U":
+		return m, m.confirmNetworkConnect(false)
+	}
+
+	return m, nil
+}
+
+// confirmNetworkConnect asks to connect (or disconnect) the containers selected in the
+// container list (or the list cursor container) to/from the network under the cursor
+func (m *model) confirmNetworkConnect(connect bool) tea.Cmd {
+	if m.networksCursor < 0 || m.networksCursor >= len(m.networkRows) {
+		return nil
+	}
+	row := m.networkRows[m.networksCursor]
+
+	attached := make(map[string]bool)
+	for _, c := range row.containers(m.networkContainers()) {
+		attached[c.id] = true
+	}
+
+	// Only keep containers whose state actually changes
+	selected := m.getSelectedIDs()
+	ids := []string{}
+	names := []string{}
+	m.containersMu.RLock()
+	for _, id := range selected {
+		if attached[id] == connect {
+			continue
+		}
+		ids = append(ids, id)
+		for _, c := range m.containers {
+			if c.ID == id {
+				names = append(names, getContainerName(c))
+				break
+			}
+		}
+	}
+	m.containersMu.RUnlock()
+
+	action, verb, prep := "connect-network", "Connect", "to"
+	if !connect {
+		action, verb, prep = "disconnect-network", "Disconnect", "from"
+	}
+
+	if len(ids) == 0 {
+		message := fmt.Sprintf("%s: select containers in the container list first", strings.ToLower(verb))
+		if len(selected) > 0 {
+			state := "already connected to"
+			if !connect {
+				state = "not connected to"
+			}
+			message = fmt.Sprintf("%s: container(s) %s %s", strings.ToLower(verb), state, row.name)
+		}
+		return func() tea.Msg {
+			return toastMsg{message: message, isError: true}
+		}
+	}
+
+	m.pendingNetworkID = row.id
+	m.showViewConfirmation(networksView, action, ids,
+		fmt.Sprintf("%s %d container(s) %s network %s?\n\n%s\n\nPress Y to confirm, N to cancel",
+			verb, len(ids), prep, row.name, strings.Join(names, "\n")))
+	return nil
+}
+
+// performNetworkAction runs a confirmed networks view action
+func (m *model) performNetworkAction(action string, targets []string) tea.Cmd {
+	switch action {
+	case "remove-networks":
+		for _, id := range targets {
+			delete(m.networksSelected, id)
+		}
+		return removeNetworks(m.dockerClient, targets)
+	case "prune-networks":
+		return pruneNetworks(m.dockerClient)
+	case "connect-network", "disconnect-network":
+		networkID := m.pendingNetworkID
+		m.pendingNetworkID = ""
+		return connectNetwork(m.dockerClient, m.reloadContainers(), networkID, targets, action == "connect-network")
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openVolumesView switches to the volumes view and (re)loads the volume list
+func (m *model) openVolumesView() tea.Cmd {
+	m.view = volumesView
+	m.volumesLoading = true
+	if m.volumesSelected == nil {
+		m.volumesSelected = make(map[string]bool)
+	}
+	return loadVolumes(m.dockerClient)
+}
+
+// handleVolumesViewKeys handles keyboard input in volumes view
+func (m *model) handleVolumesViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if moveRowCursor(msg.String(), &m.volumesCursor, len(m.volumeRows)) {
+		return m, nil
+	}
+
+	switch msg.String() {
+	case "ctrl+c":
+		return m, tea.Quit
+
+	case "esc", "q", "Q":
+		m.view = listView
+		return m, nil
+
+	case "tab":
+		return m, m.cycleView(true)
+	case "shift+tab":
+		return m, m.cycleView(false)
+
+	case " ":
+		if m.volumesCursor >= 0 && m.volumesCursor < len(m.volumeRows) {
+			name := m.volumeRows[m.volumesCursor].name
+			m.volumesSelected[name] = !m.volumesSelected[name]
+		}
+	case "a", "A":
+		m.volumesSelected = make(map[string]bool)
+		for _, row := range m.volumeRows {
+			m.volumesSelected[row.name] = true
+		}
+	case "x", "X":
+		m.volumesSelected = make(map[string]bool)
+	case "i", "I":
+		for _, row := range m.volumeRows {
+			m.volumesSelected[row.name] = !m.volumesSelected[row.name]
+		}
+
+	case "r", "R":
+		m.volumesLoading = true
+		return m, loadVolumes(m.dockerClient)
+
+	case "d", "D":
+		names := m.selectedVolumeNames()
+		if len(names) > 0 {
+			m.showViewConfirmation(volumesView, "remove-volumes", names,
+				fmt.Sprintf("Remove %d volume(s)?\n\n%s\n\n⚠ Volume data will be deleted. This action cannot be undone!\n\nPress Y to confirm, N to cancel",
+					len(names), strings.Join(names, "\n")))
+		}
+
+	case "p", "P":
+		m.showViewConfirmation(volumesView, "prune-volumes", nil,
+			"Prune unused volumes?\n\nAnonymous volumes not used by any container will be deleted\n(named volumes are kept, like docker volume prune).\n\n⚠ This action cannot be undone!\n\nPress Y to confirm, N to cancel")
+	}
+
+	return m, nil
+}
+
+// performVolumeAction runs a confirmed volumes view action
+func (m *model) performVolumeAction(action string, targets []string) tea.Cmd {
+	switch action {
+	case "remove-volumes":
+		for _, name := range targets {
+			delete(m.volumesSelected, name)
+		}
+		return removeVolumes(m.dockerClient, targets)
+	case "prune-volumes":
+		return pruneVolumes(m.dockerClient)
+	}
+	return nil
+}
@@ -0,0 +1,599 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// endpointConfig describes how to reach a Docker daemon (from --host, --context or the environment)
+type endpointConfig struct {
+	name          string // Display name: context name, host URL, or "default"
+	host          string // Daemon URL (unix://, tcp://, ssh://...), "" = environment (DOCKER_HOST...)
+	tlsDir        string // Context TLS material (ca.pem, cert.pem, key.pem), "" = none
+	skipTLSVerify bool
+}
+
+// dockerHost is a connected Docker daemon with its own client, LogBroker, rate tracker,
+// events subscription and stats. All hosts keep streaming and polling stats in the background
+// so that switching is instant.
+type dockerHost struct {
+	name         string
+	client       *client.Client
+	logBroker    *LogBroker
+	rateTracker  *RateTrackerConsumer
+	eventWatcher *EventWatcher
+	statsCache   *StatsCache // Stats of the host containers (served by MCP for the first host)
+	stats        *hostStats  // CPU and resource state, swapped into the model while the host is active
+}
+
+// hostStats is the per-container CPU and resource state of a host (model fields of the active host)
+type hostStats struct {
+	cpuStats     map[string][]float64                // CPU history per container (last 10 values)
+	cpuCurrent   map[string]float64                  // Current CPU percentage per container
+	cpuPrevStats map[string]*container.StatsResponse // Previous stats for delta calculation
+	statsCurrent map[string]ContainerStats           // Current memory/network/block/PID stats per container
+}
+
+// newHostStats creates an empty hostStats
+func newHostStats() *hostStats {
+	return &hostStats{
+		cpuStats:     make(map[string][]float64),
+		cpuCurrent:   make(map[string]float64),
+		cpuPrevStats: make(map[string]*container.StatsResponse),
+		statsCurrent: make(map[string]ContainerStats),
+	}
+}
+
+// apply records a cpuStatsMsg and returns the stats to publish in the stats cache
+func (s *hostStats) apply(msg cpuStatsMsg) map[string]ContainerStats {
+	for containerID, cpuPercent := range msg.stats {
+		// Update current value
+		s.cpuCurrent[containerID] = cpuPercent
+
+		// Add to history (keep last 10 values)
+		history := s.cpuStats[containerID]
+		history = append(history, cpuPercent)
+		if len(history) > 10 {
+			history = history[1:]
+		}
+		s.cpuStats[containerID] = history
+	}
+
+	// Store raw stats as previous for next iteration
+	for containerID, rawStats := range msg.rawStats {
+		s.cpuPrevStats[containerID] = rawStats
+	}
+
+	// Memory, network, block I/O and PID stats (history is kept by the stats cache)
+	if s.statsCurrent == nil {
+		s.statsCurrent = make(map[string]ContainerStats)
+	}
+	for containerID, stats := range msg.containerStats {
+		s.statsCurrent[containerID] = stats
+	}
+
+	statsCopy := make(map[string]ContainerStats, len(s.cpuCurrent))
+	for k, v := range s.cpuCurrent {
+		stats := s.statsCurrent[k]
+		stats.CPUPercent = v
+		statsCopy[k] = stats
+	}
+	return statsCopy
+}
+
+// prune drops the state of containers missing from currentIDs
+func (s *hostStats) prune(currentIDs map[string]bool) {
+	toDelete := make(map[string]bool)
+	for id := range s.cpuStats {
+		if !currentIDs[id] {
+			toDelete[id] = true
+		}
+	}
+	for id := range s.cpuPrevStats {
+		if !currentIDs[id] {
+			toDelete[id] = true
+		}
+	}
+	for id := range s.statsCurrent {
+		if !currentIDs[id] {
+			toDelete[id] = true
+		}
+	}
+	for id := range s.cpuCurrent {
+		if !currentIDs[id] {
+			toDelete[id] = true
+		}
+	}
+	for id := range toDelete {
+		delete(s.cpuStats, id)
+		delete(s.cpuCurrent, id)
+		delete(s.cpuPrevStats, id)
+		delete(s.statsCurrent, id)
+	}
+}
+
+// contextMeta is the part of ~/.docker/contexts/meta/<hash>/meta.json used by docker-tui
+type contextMeta struct {
+	Name      string `json:"Name"`
+	Endpoints map[string]struct {
+		Host          string `json:"Host"`
+		SkipTLSVerify bool   `json:"SkipTLSVerify"`
+	} `json:"Endpoints"`
+}
+
+// dockerConfigDir returns the Docker CLI configuration directory ($DOCKER_CONFIG or ~/.docker)
+func dockerConfigDir() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return dir
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".docker"
+	}
+	return filepath.Join(home, ".docker")
+}
+
+// contextDirName returns the directory name of a context (sha256 of its name, like the Docker CLI)
+func contextDirName(name string) string {
+	sum := sha256.Sum256([]byte(name))
+	return hex.EncodeToString(sum[:])
+}
+
+// currentContextName returns the context selected by DOCKER_CONTEXT or config.json ("" = default)
+func currentContextName() string {
+	if name := os.Getenv("DOCKER_CONTEXT"); name != "" {
+		return name
+	}
+	data, err := os.ReadFile(filepath.Join(dockerConfigDir(), "config.json"))
+	if err != nil {
+		return ""
+	}
+	var cfg struct {
+		CurrentContext string `json:"currentContext"`
+	}
+	if json.Unmarshal(data, &cfg) != nil {
+		return ""
+	}
+	return cfg.CurrentContext
+}
+
+// loadContextEndpoint reads the docker endpoint of a context from the contexts metadata store
+func loadContextEndpoint(name string) (endpointConfig, error) {
+	if name == "default" {
+		return endpointConfig{name: name}, nil
+	}
+
+	dir := contextDirName(name)
+	data, err := os.ReadFile(filepath.Join(dockerConfigDir(), "contexts", "meta", dir, "meta.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return endpointConfig{}, fmt.Errorf("context %q not found", name)
+		}
+		return endpointConfig{}, fmt.Errorf("context %q: %w", name, err)
+	}
+
+	var meta contextMeta
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return endpointConfig{}, fmt.Errorf("context %q: invalid metadata: %w", name, err)
+	}
+	endpoint, ok := meta.Endpoints["docker"]
+	if !ok || endpoint.Host == "" {
+		return endpointConfig{}, fmt.Errorf("context %q has no docker endpoint", name)
+	}
+
+	cfg := endpointConfig{name: name, host: endpoint.Host, skipTLSVerify: endpoint.SkipTLSVerify}
+	tlsDir := filepath.Join(dockerConfigDir(), "contexts", "tls", dir, "docker")
+	if info, err := os.Stat(tlsDir); err == nil && info.IsDir() {
+		cfg.tlsDir = tlsDir
+	}
+	return cfg, nil
+}
+
+// resolveEndpoints builds the endpoint list from --context and --host values
+// Without flags, the current context (DOCKER_CONTEXT or config.json) is used unless DOCKER_HOST is set.
+func resolveEndpoints(contexts, hosts []string) ([]endpointConfig, error) {
+	endpoints := []endpointConfig{}
+	for _, name := range contexts {
+		cfg, err := loadContextEndpoint(name)
+		if err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, cfg)
+	}
+	for _, host := range hosts {
+		endpoints = append(endpoints, endpointConfig{name: hostDisplayName(host), host: host})
+	}
+	if len(endpoints) > 0 {
+		return endpoints, nil
+	}
+
+	if os.Getenv("DOCKER_HOST") == "" {
+		if name := currentContextName(); name != "" && name != "default" {
+			cfg, err := loadContextEndpoint(name)
+			if err != nil {
+				return nil, err
+			}
+			return []endpointConfig{cfg}, nil
+		}
+	}
+	return []endpointConfig{{name: "default"}}, nil
+}
+
+// hostDisplayName shortens a daemon URL for display (tcp://10.0.0.5:2376 -> 10.0.0.5:2376)
+func hostDisplayName(host string) string {
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return host
+}
+
+// splitFlagValues splits comma separated flag values (--context a,b --context c)
+func splitFlagValues(value string) []string {
+	values := []string{}
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// newEndpointClient creates a Docker client for an endpoint
+func newEndpointClient(cfg endpointConfig) (*client.Client, error) {
+	// Environment (DOCKER_HOST, DOCKER_TLS_VERIFY, DOCKER_CERT_PATH...): same as the Docker CLI
+	if cfg.host == "" {
+		return client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	}
+
+	if strings.HasPrefix(cfg.host, "ssh://") {
+		dialer, err := sshDialer(cfg.host)
+		if err != nil {
+			return nil, err
+		}
+		// The host is a placeholder: every connection goes through `docker system dial-stdio`
+		return client.NewClientWithOpts(
+			client.WithHost("http://docker.example.com"),
+			client.WithDialContext(dialer),
+			client.WithAPIVersionNegotiation(),
+		)
+	}
+
+	if cfg.tlsDir != "" || cfg.skipTLSVerify {
+		tlsConfig, err := contextTLSConfig(cfg.tlsDir, cfg.skipTLSVerify)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", cfg.name, err)
+		}
+		// WithHost configures the dialer of the transport, TLS makes the client use https
+		return client.NewClientWithOpts(
+			client.WithHTTPClient(&http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}),
+			client.WithHost(cfg.host),
+			client.WithAPIVersionNegotiation(),
+		)
+	}
+
+	// --host: TLS settings still come from DOCKER_TLS_VERIFY / DOCKER_CERT_PATH
+	return client.NewClientWithOpts(client.FromEnv, client.WithHost(cfg.host), client.WithAPIVersionNegotiation())
+}
+
+// contextTLSConfig builds the TLS configuration from a context TLS directory
+func contextTLSConfig(dir string, skipVerify bool) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: skipVerify,
+	}
+	if dir == "" {
+		return config, nil
+	}
+
+	if ca, err := os.ReadFile(filepath.Join(dir, "ca.pem")); err == nil {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("invalid CA certificate in %s", dir)
+		}
+		config.RootCAs = pool
+	}
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if _, err := os.Stat(certFile); err == nil {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("client certificate: %w", err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// sshArgs returns the ssh command line reaching the daemon of an ssh://[user@]host[:port] URL
+func sshArgs(host string) ([]string, error) {
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid ssh host %q", host)
+	}
+
+	// BatchMode: the TUI owns the terminal, password prompts would corrupt it (use keys or an agent)
+	args := []string{"-o", "BatchMode=yes"}
+	if u.User != nil && u.User.Username() != "" {
+		args = append(args, "-l", u.User.Username())
+	}
+	if port := u.Port(); port != "" {
+		args = append(args, "-p", port)
+	}
+	return append(args, "--", u.Hostname(), "docker", "system", "dial-stdio"), nil
+}
+
+// sshDialer returns a dialer running `docker system dial-stdio` on the remote host over ssh
+func sshDialer(host string) (func(ctx context.Context, network, addr string) (net.Conn, error), error) {
+	args, err := sshArgs(host)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		// Not bound to ctx: the connection outlives the dial (streams, keep-alive)
+		cmd := exec.Command("ssh", args...)
+		stdin, err := cmd.StdinPipe()
+		if err != nil {
+			return nil, err
+		}
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := cmd.Start(); err != nil {
+			return nil, fmt.Errorf("ssh: %w", err)
+		}
+		return &commandConn{cmd: cmd, stdin: stdin, stdout: stdout}, nil
+	}, nil
+}
+
+// commandConn is a net.Conn over the stdin/stdout of a command (ssh ... docker system dial-stdio)
+type commandConn struct {
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+}
+
+func (c *commandConn) Read(p []byte) (int, error)  { return c.stdout.Read(p) }
+func (c *commandConn) Write(p []byte) (int, error) { return c.stdin.Write(p) }
+
+// Close stops the command (closing stdin alone may leave ssh running)
+func (c *commandConn) Close() error {
+	c.stdin.Close()
+	if c.cmd.Process != nil {
+		c.cmd.Process.Kill()
+	}
+	c.cmd.Wait()
+	return nil
+}
+
+func (c *commandConn) LocalAddr() net.Addr                { return commandAddr{} }
+func (c *commandConn) RemoteAddr() net.Addr               { return commandAddr{} }
+func (c *commandConn) SetDeadline(t time.Time) error      { return nil }
+func (c *commandConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *commandConn) SetWriteDeadline(t time.Time) error { return nil }
+
+// commandAddr is the placeholder address of a commandConn
+type commandAddr struct{}
+
+func (commandAddr) Network() string { return "ssh" }
+func (commandAddr) String() string  { return "ssh" }
+
+// newDockerHost connects to an endpoint and creates its LogBroker, rate tracker and EventWatcher
+// The EventWatcher is not started: call Start once the TUI is ready.
+func newDockerHost(cfg endpointConfig) (*dockerHost, error) {
+	cli, err := newEndpointClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", cfg.name, err)
+	}
+
+	logBroker := NewLogBroker(cli)
+	rateTracker := NewRateTrackerConsumer()
+	logBroker.RegisterConsumer(rateTracker)
+
+	return &dockerHost{
+		name:         cfg.name,
+		client:       cli,
+		logBroker:    logBroker,
+		rateTracker:  rateTracker,
+		eventWatcher: NewEventWatcher(cli, logBroker),
+		statsCache:   NewStatsCache(),
+		stats:        newHostStats(),
+	}, nil
+}
+
+// Start subscribes to the host events (keeps the container list and log streams in sync)
+func (h *dockerHost) Start() {
+	h.eventWatcher.Start()
+}
+
+// activeHostName returns the name of the active host ("" with a single host)
+func (m *model) activeHostName() string {
+	if len(m.hosts) < 2 || m.activeHost < 0 || m.activeHost >= len(m.hosts) {
+		return ""
+	}
+	return m.hosts[m.activeHost].name
+}
+
+// switchHost makes hosts[index] the active host of the TUI
+// Selection of the previous host is dropped; its streams and stats keep running in the background.
+func (m *model) switchHost(index int) tea.Cmd {
+	if len(m.hosts) < 2 {
+		return nil
+	}
+	index = (index + len(m.hosts)) % len(m.hosts)
+	if index == m.activeHost {
+		return nil
+	}
+	host := m.hosts[index]
+	previous := m.hosts[m.activeHost]
+
+	m.activeHost = index
+	m.dockerClient = host.client
+	m.logBroker = host.logBroker
+	m.rateTracker = host.rateTracker
+	m.eventWatcher = host.eventWatcher
+
+	// Drop queued notifications BEFORE taking the snapshot: later events apply on top of it
+	host.eventWatcher.Drain()
+	containers := host.eventWatcher.GetContainers()
+
+	m.containersMu.Lock()
+	m.containers = containers
+	m.cursor = 0
+	m.containersMu.Unlock()
+	m.shiftStart = -1
+
+	m.selectedMu.Lock()
+	m.selected = make(map[string]bool)
+	m.selectedMu.Unlock()
+
+	// Stats history is kept per host: switching back shows it again
+	m.cpuStatsMu.Lock()
+	previous.stats = m.activeStats()
+	if host.stats == nil {
+		host.stats = newHostStats()
+	}
+	m.setActiveStats(host.stats)
+	m.cpuStatsMu.Unlock()
+	m.statsCache = host.statsCache
+
+	return tea.Batch(
+		m.waitForActiveEvents(),
+		m.reloadContainers(),
+		func() tea.Msg {
+			return toastMsg{message: fmt.Sprintf("host: %s (%d/%d)", host.name, index+1, len(m.hosts))}
+		},
+	)
+}
+
+// activeStats returns the stats state of the active host (caller holds cpuStatsMu)
+func (m *model) activeStats() *hostStats {
+	return &hostStats{
+		cpuStats:     m.cpuStats,
+		cpuCurrent:   m.cpuCurrent,
+		cpuPrevStats: m.cpuPrevStats,
+		statsCurrent: m.statsCurrent,
+	}
+}
+
+// setActiveStats makes s the stats state shown by the TUI (caller holds cpuStatsMu)
+func (m *model) setActiveStats(s *hostStats) {
+	m.cpuStats = s.cpuStats
+	m.cpuCurrent = s.cpuCurrent
+	m.cpuPrevStats = s.cpuPrevStats
+	m.statsCurrent = s.statsCurrent
+}
+
+// fetchHostCPUStats polls the stats of hosts[index] (the active host without multi-host)
+// The active host polls the containers of the TUI list, background hosts those of their EventWatcher.
+func (m *model) fetchHostCPUStats(index int) tea.Cmd {
+	cli := m.dockerClient
+	var containers []types.Container
+	if index == m.activeHost {
+		// CRITICAL FIX: Protect read of m.containers with mutex to prevent race condition
+		m.containersMu.RLock()
+		containers = make([]types.Container, len(m.containers))
+		copy(containers, m.containers)
+		m.containersMu.RUnlock()
+	} else {
+		host := m.hosts[index]
+		cli = host.client
+		containers = host.eventWatcher.GetContainers()
+	}
+
+	// CRITICAL FIX: Also protect read of the previous stats with mutex
+	m.cpuStatsMu.RLock()
+	prevStats := m.cpuPrevStats
+	if index != m.activeHost {
+		prevStats = nil
+		if host := m.hosts[index]; host.stats != nil {
+			prevStats = host.stats.cpuPrevStats
+		}
+	}
+	prevStatsCopy := make(map[string]*container.StatsResponse, len(prevStats))
+	for k, v := range prevStats {
+		prevStatsCopy[k] = v
+	}
+	m.cpuStatsMu.RUnlock()
+
+	fetch := fetchCPUStats(cli, containers, prevStatsCopy)
+	return func() tea.Msg {
+		msg := fetch()
+		if stats, ok := msg.(cpuStatsMsg); ok {
+			stats.host = index
+			return stats
+		}
+		return msg
+	}
+}
+
+// hostContainerListMsg is a container list loaded for a given host (multi-host mode)
+type hostContainerListMsg struct {
+	host       int // Index in model.hosts
+	containers []types.Container
+}
+
+// reloadContainers loads the container list of the active host
+// With several hosts the result is tagged so that a list loaded before a host switch is ignored.
+func (m *model) reloadContainers() tea.Cmd {
+	load := loadContainers(m.dockerClient)
+	if len(m.hosts) < 2 {
+		return load
+	}
+	index := m.activeHost
+	return func() tea.Msg {
+		msg := load()
+		if list, ok := msg.(containerListMsg); ok {
+			return hostContainerListMsg{host: index, containers: list}
+		}
+		return msg
+	}
+}
+
+// waitForActiveEvents listens for the next notification of the active host's EventWatcher
+// A watcher is never read by two commands at once (a pending read may survive host switches).
+func (m *model) waitForActiveEvents() tea.Cmd {
+	if m.eventWatcher == nil {
+		return nil
+	}
+	if m.eventWaiting == nil {
+		m.eventWaiting = make(map[*EventWatcher]bool)
+	}
+	if m.eventWaiting[m.eventWatcher] {
+		return nil
+	}
+	m.eventWaiting[m.eventWatcher] = true
+	return waitForDockerEvent(m.eventWatcher.Updates())
+}
+
+// acceptEvent records that a notification was read from source and reports whether it
+// belongs to the active host (nil source = active host)
+func (m *model) acceptEvent(source *EventWatcher) bool {
+	if source == nil {
+		source = m.eventWatcher
+	}
+	delete(m.eventWaiting, source)
+	return source == m.eventWatcher
+}
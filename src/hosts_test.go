@@ -0,0 +1,321 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+)
+
+// writeTestContext creates a context in the metadata store of a temporary DOCKER_CONFIG
+func writeTestContext(t *testing.T, configDir, name, host string, withTLS bool) {
+	t.Helper()
+	metaDir := filepath.Join(configDir, "contexts", "meta", contextDirName(name))
+	if err := os.MkdirAll(metaDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	meta := `{"Name":"` + name + `","Metadata":{},"Endpoints":{"docker":{"Host":"` + host + `","SkipTLSVerify":false}}}`
+	if err := os.WriteFile(filepath.Join(metaDir, "meta.json"), []byte(meta), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if withTLS {
+		if err := os.MkdirAll(filepath.Join(configDir, "contexts", "tls", contextDirName(name), "docker"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// TestLoadContextEndpoint tests reading a context from the metadata store
+func TestLoadContextEndpoint(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DOCKER_CONFIG", configDir)
+	writeTestContext(t, configDir, "staging1", "tcp://10.0.0.1:2376", true)
+	writeTestContext(t, configDir, "local", "unix:///var/run/docker.sock", false)
+
+	cfg, err := loadContextEndpoint("staging1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.name != "staging1" || cfg.host != "tcp://10.0.0.1:2376" {
+		t.Errorf("Unexpected endpoint: %+v", cfg)
+	}
+	if !strings.HasSuffix(cfg.tlsDir, filepath.Join(contextDirName("staging1"), "docker")) {
+		t.Errorf("Expected context TLS directory, got %q", cfg.tlsDir)
+	}
+
+	cfg, err = loadContextEndpoint("local")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.tlsDir != "" {
+		t.Errorf("Expected no TLS directory, got %q", cfg.tlsDir)
+	}
+
+	// "default" is the environment endpoint
+	cfg, err = loadContextEndpoint("default")
+	if err != nil || cfg.host != "" {
+		t.Errorf("Expected environment endpoint for default context, got %+v (%v)", cfg, err)
+	}
+
+	if _, err := loadContextEndpoint("missing"); err == nil {
+		t.Error("Expected error for unknown context")
+	}
+}
+
+// TestResolveEndpoints tests endpoint selection from flags, DOCKER_CONTEXT and config.json
+func TestResolveEndpoints(t *testing.T) {
+	configDir := t.TempDir()
+	t.Setenv("DOCKER_CONFIG", configDir)
+	t.Setenv("DOCKER_HOST", "")
+	t.Setenv("DOCKER_CONTEXT", "")
+	writeTestContext(t, configDir, "staging1", "tcp://10.0.0.1:2376", false)
+	writeTestContext(t, configDir, "staging2", "ssh://deploy@10.0.0.2", false)
+
+	// No flags, no current context: environment
+	endpoints, err := resolveEndpoints(nil, nil)
+	if err != nil || len(endpoints) != 1 || endpoints[0].name != "default" || endpoints[0].host != "" {
+		t.Errorf("Expected default endpoint, got %+v (%v)", endpoints, err)
+	}
+
+	// Current context from config.json
+	if err := os.WriteFile(filepath.Join(configDir, "config.json"), []byte(`{"currentContext":"staging2"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	endpoints, err = resolveEndpoints(nil, nil)
+	if err != nil || len(endpoints) != 1 || endpoints[0].name != "staging2" {
+		t.Errorf("Expected current context staging2, got %+v (%v)", endpoints, err)
+	}
+
+	// DOCKER_CONTEXT wins over config.json
+	t.Setenv("DOCKER_CONTEXT", "staging1")
+	endpoints, err = resolveEndpoints(nil, nil)
+	if err != nil || len(endpoints) != 1 || endpoints[0].name != "staging1" {
+		t.Errorf("Expected DOCKER_CONTEXT staging1, got %+v (%v)", endpoints, err)
+	}
+
+	// DOCKER_HOST wins over the current context
+	t.Setenv("DOCKER_HOST", "tcp://127.0.0.1:2375")
+	endpoints, err = resolveEndpoints(nil, nil)
+	if err != nil || len(endpoints) != 1 || endpoints[0].host != "" {
+		t.Errorf("Expected environment endpoint with DOCKER_HOST, got %+v (%v)", endpoints, err)
+	}
+
+	// Flags: contexts first, then hosts
+	endpoints, err = resolveEndpoints([]string{"staging1", "staging2"}, []string{"tcp://10.0.0.3:2375"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	names := []string{}
+	for _, e := range endpoints {
+		names = append(names, e.name)
+	}
+	if strings.Join(names, ",") != "staging1,staging2,10.0.0.3:2375" {
+		t.Errorf("Unexpected endpoints: %v", names)
+	}
+
+	if _, err := resolveEndpoints([]string{"missing"}, nil); err == nil {
+		t.Error("Expected error for unknown context")
+	}
+}
+
+// TestSplitFlagValues tests comma separated flag values
+func TestSplitFlagValues(t *testing.T) {
+	got := splitFlagValues(" a, b ,,c")
+	if strings.Join(got, "|") != "a|b|c" {
+		t.Errorf("splitFlagValues = %v, want [a b c]", got)
+	}
+	if len(splitFlagValues("")) != 0 {
+		t.Error("Expected no value for empty flag")
+	}
+}
+
+// TestSSHArgs tests the ssh command line built from ssh:// URLs
+func TestSSHArgs(t *testing.T) {
+	tests := []struct {
+		host     string
+		expected string
+	}{
+		{"ssh://10.0.0.5", "-o BatchMode=yes -- 10.0.0.5 docker system dial-stdio"},
+		{"ssh://deploy@10.0.0.5", "-o BatchMode=yes -l deploy -- 10.0.0.5 docker system dial-stdio"},
+		{"ssh://deploy@host.example:2222", "-o BatchMode=yes -l deploy -p 2222 -- host.example docker system dial-stdio"},
+	}
+	for _, tt := range tests {
+		args, err := sshArgs(tt.host)
+		if err != nil {
+			t.Errorf("sshArgs(%q) error: %v", tt.host, err)
+			continue
+		}
+		if got := strings.Join(args, " "); got != tt.expected {
+			t.Errorf("sshArgs(%q) = %q, want %q", tt.host, got, tt.expected)
+		}
+	}
+
+	if _, err := sshArgs("ssh://"); err == nil {
+		t.Error("Expected error for ssh URL without host")
+	}
+}
+
+// TestHostDisplayName tests host URL shortening
+func TestHostDisplayName(t *testing.T) {
+	if got := hostDisplayName("tcp://10.0.0.5:2376"); got != "10.0.0.5:2376" {
+		t.Errorf("Expected 10.0.0.5:2376, got %q", got)
+	}
+	if got := hostDisplayName("ssh://deploy@10.0.0.5"); got != "10.0.0.5" {
+		t.Errorf("Expected 10.0.0.5, got %q", got)
+	}
+	if got := hostDisplayName("unix:///var/run/docker.sock"); got != "unix:///var/run/docker.sock" {
+		t.Errorf("Expected unix URL unchanged, got %q", got)
+	}
+}
+
+// newTestHost creates a host without Docker connection, with a given container list
+func newTestHost(name string, containers []types.Container) *dockerHost {
+	logBroker := NewLogBroker(nil)
+	watcher := NewEventWatcher(nil, nil)
+	watcher.containers = containers
+	return &dockerHost{
+		name:         name,
+		logBroker:    logBroker,
+		rateTracker:  NewRateTrackerConsumer(),
+		eventWatcher: watcher,
+	}
+}
+
+// TestSwitchHost tests switching the TUI to another host
+func TestSwitchHost(t *testing.T) {
+	m := createTestModel()
+	hostA := newTestHost("a", []types.Container{{ID: "a1", Names: []string{"/a1"}}})
+	hostB := newTestHost("b", []types.Container{{ID: "b1", Names: []string{"/b1"}}, {ID: "b2", Names: []string{"/b2"}}})
+	m.hosts = []*dockerHost{hostA, hostB}
+	m.logBroker = hostA.logBroker
+	m.eventWatcher = hostA.eventWatcher
+	m.containers = hostA.eventWatcher.GetContainers()
+	m.selected["a1"] = true
+	m.cpuCurrent["a1"] = 12.5
+
+	// Notification queued before the switch: replaced by the snapshot
+	hostB.eventWatcher.msgChan <- dockerResyncMsg{containers: nil, source: hostB.eventWatcher}
+
+	if cmd := m.switchHost(m.activeHost + 1); cmd == nil {
+		t.Fatal("Expected commands after host switch")
+	}
+	if m.activeHost != 1 || m.eventWatcher != hostB.eventWatcher || m.logBroker != hostB.logBroker {
+		t.Error("Expected host b to be active")
+	}
+	if len(m.containers) != 2 || m.containers[0].ID != "b1" {
+		t.Errorf("Expected host b containers, got %d", len(m.containers))
+	}
+	if len(m.selected) != 0 || len(m.cpuCurrent) != 0 {
+		t.Error("Expected per-container state of host a to be hidden")
+	}
+	if len(hostB.eventWatcher.Updates()) != 0 {
+		t.Error("Expected queued notifications to be drained")
+	}
+	if got := m.activeHostName(); got != "b" {
+		t.Errorf("Expected active host name b, got %q", got)
+	}
+
+	// Wraps around, stats of host a are kept
+	m.switchHost(m.activeHost + 1)
+	if m.activeHost != 0 {
+		t.Errorf("Expected wrap to host 0, got %d", m.activeHost)
+	}
+	if m.cpuCurrent["a1"] != 12.5 {
+		t.Errorf("Expected CPU stats of host a to be restored, got %v", m.cpuCurrent)
+	}
+
+	// Single host: no-op
+	m.hosts = m.hosts[:1]
+	if cmd := m.switchHost(1); cmd != nil {
+		t.Error("Expected no switch with a single host")
+	}
+	if m.activeHostName() != "" {
+		t.Error("Expected no host name with a single host")
+	}
+}
+
+// TestBackgroundHostEventsIgnored tests that events of inactive hosts don't touch the list
+func TestBackgroundHostEventsIgnored(t *testing.T) {
+	m := createTestModel()
+	hostA := newTestHost("a", nil)
+	hostB := newTestHost("b", nil)
+	m.hosts = []*dockerHost{hostA, hostB}
+	m.eventWatcher = hostA.eventWatcher
+	m.containers = []types.Container{{ID: "a1", Names: []string{"/a1"}}}
+
+	// Event from the background host: dropped, not re-armed
+	_, cmd := m.Update(containerEventMsg{action: "destroy", containerID: "a1", source: hostB.eventWatcher})
+	if cmd != nil {
+		t.Error("Expected no command for a background host event")
+	}
+	if len(m.containers) != 1 {
+		t.Error("Expected container list unchanged by background host event")
+	}
+
+	// Stale container list of the previous host
+	m.Update(hostContainerListMsg{host: 1, containers: []types.Container{}})
+	if len(m.containers) != 1 {
+		t.Error("Expected container list unchanged by stale host list")
+	}
+
+	// Event from the active host: applied and re-armed
+	_, cmd = m.Update(containerEventMsg{action: "destroy", containerID: "a1", source: hostA.eventWatcher})
+	if len(m.containers) != 0 {
+		t.Error("Expected active host event to be applied")
+	}
+	if cmd == nil {
+		t.Error("Expected wait command for the active host")
+	}
+
+	// A pending wait is never duplicated
+	if m.waitForActiveEvents() != nil {
+		t.Error("Expected no second wait on the same watcher")
+	}
+}
+
+// TestBackgroundHostStats tests that each host keeps its own stats and stats cache
+func TestBackgroundHostStats(t *testing.T) {
+	m := createTestModel()
+	hostA := newTestHost("a", []types.Container{{ID: "a1", Names: []string{"/a1"}}})
+	hostB := newTestHost("b", []types.Container{{ID: "b1", Names: []string{"/b1"}}})
+	hostA.statsCache = NewStatsCache()
+	hostB.statsCache = NewStatsCache()
+	m.hosts = []*dockerHost{hostA, hostB}
+	m.eventWatcher = hostA.eventWatcher
+	m.statsCache = hostA.statsCache
+	m.cpuPrevStats = make(map[string]*container.StatsResponse)
+	m.containers = hostA.eventWatcher.GetContainers()
+
+	// Stats of the background host go to that host only
+	m.Update(cpuStatsMsg{stats: map[string]float64{"b1": 40}, rawStats: map[string]*container.StatsResponse{"b1": {}}, host: 1})
+	if _, ok := m.cpuCurrent["b1"]; ok {
+		t.Error("Expected background host stats to stay out of the TUI")
+	}
+	if cached, ok := hostB.statsCache.GetForContainer("b1"); !ok || cached.CPUPercent != 40 {
+		t.Errorf("Expected host b cache to be updated, got %+v (ok=%v)", cached, ok)
+	}
+
+	// Host a stays polled while host b is shown (the MCP server serves host a)
+	m.switchHost(1)
+	if m.cpuCurrent["b1"] != 40 || m.statsCache != hostB.statsCache {
+		t.Errorf("Expected host b stats after the switch, got %v", m.cpuCurrent)
+	}
+	m.Update(cpuStatsMsg{stats: map[string]float64{"a1": 12}, rawStats: map[string]*container.StatsResponse{"a1": {}}, host: 0})
+	if history := hostA.statsCache.GetHistory("a1"); len(history) != 1 || history[0].CPUPercent != 12 {
+		t.Errorf("Expected host a cache to be updated in the background, got %+v", history)
+	}
+	if _, ok := m.cpuCurrent["a1"]; ok {
+		t.Error("Expected stats polled for host a to stay out of the host b view")
+	}
+
+	// Cleanup of a background host uses its own container list
+	hostA.eventWatcher.containers = nil
+	m.Update(cpuCleanupTickMsg(time.Now()))
+	if len(hostA.stats.cpuCurrent) != 0 || m.cpuCurrent["b1"] != 40 {
+		t.Errorf("Expected only host a stats to be cleaned, got %v / %v", hostA.stats.cpuCurrent, m.cpuCurrent)
+	}
+}
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+)
+
+// imageRow is one line of the images view (one per repo:tag, like `docker images`)
+type imageRow struct {
+	id         string // Full image ID (sha256:...)
+	repository string
+	tag        string
+	size       int64
+	created    time.Time
+}
+
+// ref returns the reference used to select, remove or pull the row
+// Tagged rows use repo:tag (removing untags), dangling rows use the image ID.
+func (r imageRow) ref() string {
+	if r.repository == "<none>" || r.tag == "<none>" {
+		return r.id
+	}
+	return r.repository + ":" + r.tag
+}
+
+// shortID returns the 12-character image ID, like `docker images`
+func (r imageRow) shortID() string {
+	id := strings.TrimPrefix(r.id, "sha256:")
+	return id[:min(12, len(id))]
+}
+
+// imageListMsg carries the result of ImageList
+type imageListMsg struct {
+	images []image.Summary
+	err    error
+}
+
+// imagePullProgressMsg notifies that the pull progress changed
+type imagePullProgressMsg struct{}
+
+// imagePullDoneMsg is sent when a pull finishes (successfully or not)
+type imagePullDoneMsg struct {
+	ref    string
+	status string // Last global status of the stream ("Status: Downloaded newer image for ...")
+	err    error
+}
+
+// pullMessage is one JSON message of the ImagePull stream
+type pullMessage struct {
+	Status   string `json:"status"`
+	ID       string `json:"id"`
+	Progress struct {
+		Current int64 `json:"current"`
+		Total   int64 `json:"total"`
+	} `json:"progressDetail"`
+	Error string `json:"error"`
+}
+
+// imagePullState tracks the progress of a running pull (written by the pull goroutine, read by render)
+type imagePullState struct {
+	ref     string
+	mu      sync.Mutex
+	layers  []string          // Layer IDs in arrival order
+	status  map[string]string // Layer ID -> formatted status line
+	message string            // Last global status (e.g. "Digest: sha256:...")
+	updates chan struct{}     // Notified (non-blocking) on every change, closed when done
+}
+
+// newImagePullState creates the progress state for a pull
+func newImagePullState(ref string) *imagePullState {
+	return &imagePullState{
+		ref:     ref,
+		status:  make(map[string]string),
+		updates: make(chan struct{}, 1),
+	}
+}
+
+// apply records one stream message and notifies the TUI
+func (p *imagePullState) apply(msg pullMessage) {
+	p.mu.Lock()
+	if msg.ID == "" {
+		p.message = msg.Status
+	} else {
+		if _, known := p.status[msg.ID]; !known {
+			p.layers = append(p.layers, msg.ID)
+		}
+		line := msg.Status
+		if msg.Progress.Total > 0 {
+			percent := msg.Progress.Current * 100 / msg.Progress.Total
+			line = fmt.Sprintf("%-12s %3d%% %s/%s", msg.Status, percent,
+				formatBytes(uint64(msg.Progress.Current)), formatBytes(uint64(msg.Progress.Total)))
+		}
+		p.status[msg.ID] = line
+	}
+	p.mu.Unlock()
+
+	select {
+	case p.updates <- struct{}{}:
+	default:
+		// A notification is already pending: the TUI will read the latest state anyway
+	}
+}
+
+// lastMessage returns the last global status of the stream
+func (p *imagePullState) lastMessage() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.message
+}
+
+// lines returns the progress lines for display (global message first)
+func (p *imagePullState) lines() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	lines := []string{fmt.Sprintf("Pulling %s: %s", p.ref, p.message)}
+	for _, id := range p.layers {
+		lines = append(lines, fmt.Sprintf("  %s: %s", id, p.status[id]))
+	}
+	return lines
+}
+
+// loadImages lists local images
+func loadImages(cli *client.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		images, err := cli.ImageList(ctx, image.ListOptions{})
+		return imageListMsg{images: images, err: err}
+	}
+}
+
+// buildImageRows expands image summaries into one row per tag, sorted by repository and tag
+func buildImageRows(images []image.Summary) []imageRow {
+	rows := []imageRow{}
+	for _, img := range images {
+		base := imageRow{
+			id:      img.ID,
+			size:    img.Size,
+			created: time.Unix(img.Created, 0),
+		}
+
+		tags := 0
+		for _, repoTag := range img.RepoTags {
+			if repoTag == "<none>:<none>" {
+				continue
+			}
+			row := base
+			// Split on the LAST colon: registry hosts may contain a port (host:5000/app:tag)
+			idx := strings.LastIndex(repoTag, ":")
+			if idx < 0 || strings.Contains(repoTag[idx:], "/") {
+				row.repository, row.tag = repoTag, "latest"
+			} else {
+				row.repository, row.tag = repoTag[:idx], repoTag[idx+1:]
+			}
+			rows = append(rows, row)
+			tags++
+		}
+
+		if tags == 0 {
+			// Dangling image (or digest-only): show the repository when known
+			row := base
+			row.repository, row.tag = "<none>", "<none>"
+			if len(img.RepoDigests) > 0 {
+				if idx := strings.Index(img.RepoDigests[0], "@"); idx > 0 {
+					row.repository = img.RepoDigests[0][:idx]
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].repository != rows[j].repository {
+			return rows[i].repository < rows[j].repository
+		}
+		return rows[i].tag < rows[j].tag
+	})
+	return rows
+}
+
+// imageContainerCounts returns the number of containers (running or not) using each image ID
+func (m *model) imageContainerCounts() map[string]int {
+	counts := make(map[string]int)
+	m.containersMu.RLock()
+	for _, c := range m.containers {
+		counts[c.ImageID]++
+	}
+	m.containersMu.RUnlock()
+	return counts
+}
+
+// selectedImageRefs returns the selected image refs in display order, or the cursor row
+func (m *model) selectedImageRefs() []string {
+	refs := []string{}
+	for _, row := range m.imageRows {
+		if m.imagesSelected[row.ref()] {
+			refs = append(refs, row.ref())
+		}
+	}
+	if len(refs) == 0 && m.imagesCursor >= 0 && m.imagesCursor < len(m.imageRows) {
+		refs = append(refs, m.imageRows[m.imagesCursor].ref())
+	}
+	return refs
+}
+
+// removeImages removes the given image refs (untag for repo:tag, delete for IDs)
+func removeImages(cli *client.Client, refs []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		var errors []string
+		successCount := 0
+		for _, ref := range refs {
+			// No Force: removing an image used by a container must fail and say so
+			if _, err := cli.ImageRemove(ctx, ref, image.RemoveOptions{PruneChildren: true}); err != nil {
+				errors = append(errors, fmt.Sprintf("%s: %v", ref, err))
+				continue
+			}
+			successCount++
+		}
+
+		return tea.Batch(
+			loadImages(cli),
+			func() tea.Msg { return resultToast("remove", "image", successCount, errors) },
+		)()
+	}
+}
+
+// pruneImages removes dangling images
+func pruneImages(cli *client.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		report, err := cli.ImagesPrune(ctx, filters.NewArgs(filters.Arg("dangling", "true")))
+		if err != nil {
+			return toastMsg{message: fmt.Sprintf("prune failed: %v", err), isError: true}
+		}
+
+		return tea.Batch(
+			loadImages(cli),
+			func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("prune: %d image(s) deleted, %s reclaimed",
+					len(report.ImagesDeleted), formatBytes(report.SpaceReclaimed))}
+			},
+		)()
+	}
+}
+
+// pullImage pulls ref and feeds the JSON progress stream into state
+func pullImage(cli *client.Client, state *imagePullState) tea.Cmd {
+	return func() tea.Msg {
+		defer close(state.updates)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+		defer cancel()
+
+		stream, err := cli.ImagePull(ctx, state.ref, image.PullOptions{})
+		if err != nil {
+			return imagePullDoneMsg{ref: state.ref, err: err}
+		}
+		defer stream.Close()
+
+		err = readPullStream(stream, state)
+		return imagePullDoneMsg{ref: state.ref, status: state.lastMessage(), err: err}
+	}
+}
+
+// readPullStream decodes the pull stream until EOF, returning the first error message of the stream
+func readPullStream(r io.Reader, state *imagePullState) error {
+	decoder := json.NewDecoder(r)
+	for {
+		var msg pullMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		// Errors (unknown tag, auth...) arrive in-band with a 200 status
+		if msg.Error != "" {
+			return fmt.Errorf("%s", msg.Error)
+		}
+		state.apply(msg)
+	}
+}
+
+// pullDoneToast returns the toast of a finished pull, from Docker's final status
+func pullDoneToast(msg imagePullDoneMsg) toastMsg {
+	switch {
+	case msg.err != nil:
+		return toastMsg{message: fmt.Sprintf("pull %s failed: %v", msg.ref, msg.err), isError: true}
+	case strings.Contains(msg.status, "Downloaded newer image"):
+		return toastMsg{message: fmt.Sprintf("pull: downloaded newer image for %s", msg.ref)}
+	case strings.Contains(msg.status, "Image is up to date"):
+		return toastMsg{message: fmt.Sprintf("pull: %s is up to date", msg.ref)}
+	}
+	return toastMsg{message: fmt.Sprintf("pull: %s done", msg.ref)}
+}
+
+// waitForPullProgress listens for the next pull progress notification
+func waitForPullProgress(ch chan struct{}) tea.Cmd {
+	return func() tea.Msg {
+		if _, ok := <-ch; !ok {
+			return nil // Pull finished: imagePullDoneMsg carries the result
+		}
+		return imagePullProgressMsg{}
+	}
+}
+
+// formatAge formats the time elapsed since t compactly (e.g. "5m ago", "3d ago")
+func formatAge(t time.Time) string {
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds ago", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d.Hours()))
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+	default:
+		return fmt.Sprintf("%dy ago", int(d.Hours()/(24*365)))
+	}
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/image"
+)
+
+func TestBuildImageRows(t *testing.T) {
+	images := []image.Summary{
+		{ID: "sha256:aaa111aaa111aaa111", RepoTags: []string{"nginx:latest", "nginx:1.25"}, Size: 100, Created: 1700000000},
+		{ID: "sha256:bbb222bbb222bbb222", RepoTags: []string{"localhost:5000/app:v1"}, Size: 200},
+		{ID: "sha256:ccc333ccc333ccc333", RepoTags: []string{"<none>:<none>"}, RepoDigests: []string{"redis@sha256:123"}},
+		{ID: "sha256:ddd444ddd444ddd444"},
+	}
+
+	rows := buildImageRows(images)
+	if len(rows) != 5 {
+		t.Fatalf("expected 5 rows, got %d", len(rows))
+	}
+
+	got := []string{}
+	for _, r := range rows {
+		got = append(got, r.repository+" "+r.tag)
+	}
+	want := []string{"<none> <none>", "localhost:5000/app v1", "nginx 1.25", "nginx latest", "redis <none>"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("rows = %v, want %v", got, want)
+	}
+
+	if rows[1].ref() != "localhost:5000/app:v1" {
+		t.Errorf("tagged ref = %q", rows[1].ref())
+	}
+	if rows[4].ref() != "sha256:ccc333ccc333ccc333" {
+		t.Errorf("dangling ref should be the image ID, got %q", rows[4].ref())
+	}
+	if rows[2].shortID() != "aaa111aaa111" {
+		t.Errorf("shortID = %q", rows[2].shortID())
+	}
+}
+
+func TestReadPullStream(t *testing.T) {
+	stream := `{"status":"Pulling from library/nginx","id":"latest"}
+{"status":"Downloading","id":"layer1","progressDetail":{"current":512,"total":1024}}
+{"status":"Digest: sha256:abc"}
+`
+	state := newImagePullState("nginx:latest")
+	if err := readPullStream(strings.NewReader(stream), state); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := state.lines()
+	if lines[0] != "Pulling nginx:latest: Digest: sha256:abc" {
+		t.Errorf("global line = %q", lines[0])
+	}
+	if len(lines) != 3 || !strings.Contains(lines[2], "50%") {
+		t.Errorf("layer lines = %v", lines)
+	}
+
+	// Errors are reported in-band
+	state = newImagePullState("nope:missing")
+	err := readPullStream(strings.NewReader(`{"error":"manifest unknown"}`), state)
+	if err == nil || err.Error() != "manifest unknown" {
+		t.Errorf("expected in-band error, got %v", err)
+	}
+}
+
+func TestPullDoneToast(t *testing.T) {
+	tests := []struct {
+		msg     imagePullDoneMsg
+		want    string
+		isError bool
+	}{
+		{imagePullDoneMsg{ref: "nginx:latest", status: "Status: Downloaded newer image for nginx:latest"}, "pull: downloaded newer image for nginx:latest", false},
+		{imagePullDoneMsg{ref: "nginx:latest", status: "Status: Image is up to date for nginx:latest"}, "pull: nginx:latest is up to date", false},
+		{imagePullDoneMsg{ref: "nginx:latest"}, "pull: nginx:latest done", false},
+		{imagePullDoneMsg{ref: "nope", err: errors.New("manifest unknown")}, "pull nope failed: manifest unknown", true},
+	}
+	for _, tt := range tests {
+		if got := pullDoneToast(tt.msg); got.message != tt.want || got.isError != tt.isError {
+			t.Errorf("pullDoneToast(%+v) = %+v, want %q", tt.msg, got, tt.want)
+		}
+	}
+
+	// The final status comes from the stream
+	state := newImagePullState("nginx:latest")
+	readPullStream(strings.NewReader(`{"status":"Status: Downloaded newer image for nginx:latest"}`), state)
+	if state.lastMessage() != "Status: Downloaded newer image for nginx:latest" {
+		t.Errorf("lastMessage = %q", state.lastMessage())
+	}
+}
+
+func TestImagesViewKeys(t *testing.T) {
+	m := createTestModel()
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	if m.view != imagesView || !m.imagesLoading {
+		t.Fatalf("tab should open the loading images view, got view %v", m.view)
+	}
+
+	m.Update(imageListMsg{images: []image.Summary{
+		{ID: "sha256:aaa", RepoTags: []string{"nginx:latest"}},
+		{ID: "sha256:bbb", RepoTags: []string{"redis:7"}},
+	}})
+	if m.imagesLoading || len(m.imageRows) != 2 {
+		t.Fatalf("imageListMsg should populate rows, got %d", len(m.imageRows))
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if !m.imagesSelected["redis:7"] {
+		t.Error("space should select the cursor image")
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	if m.view != confirmView || m.pendingAction != "remove-images" || m.confirmReturnView != imagesView {
+		t.Fatalf("d should ask for confirmation, got view %v action %q", m.view, m.pendingAction)
+	}
+	if len(m.pendingTargets) != 1 || m.pendingTargets[0] != "redis:7" {
+		t.Errorf("pendingTargets = %v", m.pendingTargets)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if m.view != imagesView || m.confirmReturnView != listView {
+		t.Errorf("cancel should return to images view, got %v", m.view)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	if !m.pullPromptMode || m.pullPromptInput != "redis:7" {
+		t.Errorf("u should open the pull prompt prefilled, got %v %q", m.pullPromptMode, m.pullPromptInput)
+	}
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.pullPromptMode || m.view != imagesView {
+		t.Error("esc should only close the pull prompt")
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.view != listView {
+		t.Errorf("esc should return to the list view, got %v", m.view)
+	}
+}
+
+func TestUpdate_ImageListMsg_Error(t *testing.T) {
+	m := createTestModel()
+	m.view = imagesView
+	m.imagesLoading = true
+
+	_, cmd := m.Update(imageListMsg{err: errors.New("daemon down")})
+	if m.imagesLoading {
+		t.Error("loading should stop on error")
+	}
+	if cmd == nil {
+		t.Fatal("expected a toast command")
+	}
+	if toast, ok := cmd().(toastMsg); !ok || !toast.isError {
+		t.Errorf("expected an error toast, got %#v", toast)
+	}
+}
+
+func TestRenderImages(t *testing.T) {
+	m := createTestModel()
+	m.width = 160
+	m.view = imagesView
+	m.imagesSelected = map[string]bool{"nginx:latest": true}
+	m.containers = []types.Container{{ID: "c1", ImageID: "sha256:aaa"}}
+	m.imageRows = buildImageRows([]image.Summary{
+		{ID: "sha256:aaa", RepoTags: []string{"nginx:latest"}, Size: 2048},
+		{ID: "sha256:bbb", RepoTags: []string{"<none>:<none>"}, Size: 1024},
+	})
+
+	output := m.View()
+	for _, want := range []string{"Images: 2", "Dangling: 1", "nginx", "REPOSITORY", "[U] Pull"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output should contain %q", want)
+		}
+	}
+
+	m.pullPromptMode = true
+	m.pullPromptInput = "alpine"
+	if !strings.Contains(m.View(), "Pull image: alpine") {
+		t.Error("pull prompt should be rendered")
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{30 * time.Second, "30s ago"},
+		{5 * time.Minute, "5m ago"},
+		{3 * time.Hour, "3h ago"},
+		{50 * time.Hour, "2d ago"},
+		{800 * 24 * time.Hour, "2y ago"},
+	}
+	for _, tt := range tests {
+		if got := formatAge(time.Now().Add(-tt.ago)); got != tt.want {
+			t.Errorf("formatAge(-%v) = %q, want %q", tt.ago, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,427 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// inspectMsg carries the result of a ContainerInspect call for the inspect view
+type inspectMsg struct {
+	containerID string
+	data        *container.InspectResponse
+	repoDigests []string // Repository digests of the container image (best effort)
+	err         error
+}
+
+// inspectSection is a collapsible block of the inspect view
+type inspectSection struct {
+	title string
+	lines []string
+}
+
+// inspectRow is one rendered line of the inspect view (section header or content)
+type inspectRow struct {
+	section int  // Index of the section this row belongs to
+	header  bool // True for the section title row
+	text    string
+}
+
+// fetchInspect inspects a container (and its image digests) in the background
+func fetchInspect(cli *client.Client, containerID string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		data, err := cli.ContainerInspect(ctx, containerID)
+		if err != nil {
+			return inspectMsg{containerID: containerID, err: err}
+		}
+
+		// Repo digests live on the image, not the container: ignore errors (image may be gone)
+		var repoDigests []string
+		if data.ContainerJSONBase != nil && data.Image != "" {
+			if img, err := cli.ImageInspect(ctx, data.Image); err == nil {
+				repoDigests = img.RepoDigests
+			}
+		}
+
+		return inspectMsg{containerID: containerID, data: &data, repoDigests: repoDigests}
+	}
+}
+
+// openInspect switches to the inspect view for the given container and starts loading it
+func (m *model) openInspect(containerID, containerName string) tea.Cmd {
+	m.view = inspectView
+	m.inspectContainerID = containerID
+	m.inspectContainerName = containerName
+	m.inspectSections = nil
+	m.inspectErr = nil
+	m.inspectLoading = true
+	m.inspectCursor = 0
+	m.inspectScroll = 0
+	if m.inspectCollapsed == nil {
+		m.inspectCollapsed = make(map[string]bool)
+	}
+	return fetchInspect(m.dockerClient, containerID)
+}
+
+// inspectTarget returns the container to inspect (single selection or cursor)
+func (m *model) inspectTarget() (id, name, errMsg string) {
+	selected := m.getSelectedIDs()
+	if m.countSelected() > 1 || len(selected) > 1 {
+		return "", "", "inspect: select a single container"
+	}
+	if len(selected) == 0 {
+		return "", "", ""
+	}
+
+	m.containersMu.RLock()
+	defer m.containersMu.RUnlock()
+	for _, c := range m.containers {
+		if c.ID == selected[0] {
+			return c.ID, getContainerName(c), ""
+		}
+	}
+	return "", "", ""
+}
+
+// inspectRows flattens the sections into display rows, skipping collapsed content
+func (m *model) inspectRows() []inspectRow {
+	rows := []inspectRow{}
+	for i, section := range m.inspectSections {
+		collapsed := m.inspectCollapsed[section.title]
+		icon := "▼"
+		if collapsed {
+			icon = "▶"
+		}
+		rows = append(rows, inspectRow{
+			section: i,
+			header:  true,
+			text:    fmt.Sprintf("%s %s (%d)", icon, section.title, len(section.lines)),
+		})
+		if collapsed {
+			continue
+		}
+		for _, line := range section.lines {
+			rows = append(rows, inspectRow{section: i, text: "    " + line})
+		}
+	}
+	return rows
+}
+
+// inspectVisibleLines returns the number of content lines the inspect view can display
+func (m *model) inspectVisibleLines() int {
+	// Title (2) + blank line, separator, help bar and toast (4)
+	return max(1, m.height-6)
+}
+
+// moveInspectCursor moves the cursor by delta rows and keeps it in the visible window
+func (m *model) moveInspectCursor(delta int) {
+	rowCount := len(m.inspectRows())
+	m.inspectCursor += delta
+	if m.inspectCursor >= rowCount {
+		m.inspectCursor = rowCount - 1
+	}
+	if m.inspectCursor < 0 {
+		m.inspectCursor = 0
+	}
+
+	visible := m.inspectVisibleLines()
+	if m.inspectCursor < m.inspectScroll {
+		m.inspectScroll = m.inspectCursor
+	} else if m.inspectCursor >= m.inspectScroll+visible {
+		m.inspectScroll = m.inspectCursor - visible + 1
+	}
+	m.inspectScroll = max(0, min(m.inspectScroll, rowCount-visible))
+}
+
+// toggleInspectSection collapses or expands the section under the cursor
+func (m *model) toggleInspectSection() {
+	rows := m.inspectRows()
+	if m.inspectCursor < 0 || m.inspectCursor >= len(rows) {
+		return
+	}
+	section := rows[m.inspectCursor].section
+	title := m.inspectSections[section].title
+	m.inspectCollapsed[title] = !m.inspectCollapsed[title]
+
+	// Keep the cursor on the section header so that the toggle can be undone in place
+	for i, row := range m.inspectRows() {
+		if row.header && row.section == section {
+			m.inspectCursor = i
+			break
+		}
+	}
+	m.moveInspectCursor(0)
+}
+
+// setAllInspectSections collapses or expands every section
+func (m *model) setAllInspectSections(collapsed bool) {
+	for _, section := range m.inspectSections {
+		m.inspectCollapsed[section.title] = collapsed
+	}
+	m.inspectCursor = 0
+	m.inspectScroll = 0
+}
+
+// buildInspectSections turns a ContainerInspect response into display sections
+func buildInspectSections(data *container.InspectResponse, repoDigests []string) []inspectSection {
+	// CRITICAL: Every nested pointer of InspectResponse may be nil depending on daemon/platform
+	base := data.ContainerJSONBase
+	if base == nil {
+		base = &container.ContainerJSONBase{}
+	}
+	config := data.Config
+	if config == nil {
+		config = &container.Config{}
+	}
+	hostConfig := base.HostConfig
+	if hostConfig == nil {
+		hostConfig = &container.HostConfig{}
+	}
+
+	return []inspectSection{
+		{title: "Overview", lines: inspectOverviewLines(base)},
+		{title: "Image", lines: inspectImageLines(base, config, repoDigests)},
+		{title: "Command", lines: inspectCommandLines(base, config)},
+		{title: "Environment", lines: orNone(config.Env)},
+		{title: "Mounts", lines: inspectMountLines(data.Mounts)},
+		{title: "Networks", lines: inspectNetworkLines(data.NetworkSettings)},
+		{title: "Restart Policy", lines: inspectRestartLines(hostConfig.RestartPolicy)},
+		{title: "Labels", lines: inspectLabelLines(config.Labels)},
+		{title: "Health", lines: inspectHealthLines(base.State, config.Healthcheck)},
+		{title: "Resources", lines: inspectResourceLines(hostConfig.Resources)},
+	}
+}
+
+// kv formats a key/value line with aligned values
+func kv(key, value string) string {
+	return fmt.Sprintf("%-14s %s", key+":", value)
+}
+
+// orNone returns the lines, or a placeholder when there are none
+func orNone(lines []string) []string {
+	if len(lines) == 0 {
+		return []string{"(none)"}
+	}
+	return lines
+}
+
+// inspectOverviewLines lists identity and lifecycle state
+func inspectOverviewLines(base *container.ContainerJSONBase) []string {
+	lines := []string{
+		kv("Name", strings.TrimPrefix(base.Name, "/")),
+		kv("ID", base.ID),
+		kv("Created", base.Created),
+	}
+	if state := base.State; state != nil {
+		lines = append(lines,
+			kv("State", string(state.Status)),
+			kv("PID", fmt.Sprintf("%d", state.Pid)),
+			kv("Exit Code", fmt.Sprintf("%d", state.ExitCode)),
+			kv("Started", state.StartedAt),
+			kv("Finished", state.FinishedAt),
+			kv("OOM Killed", fmt.Sprintf("%t", state.OOMKilled)),
+		)
+		if state.Error != "" {
+			lines = append(lines, kv("Error", state.Error))
+		}
+	}
+	lines = append(lines, kv("Restarts", fmt.Sprintf("%d", base.RestartCount)))
+	return lines
+}
+
+// inspectImageLines lists the image reference, image ID and repository digests
+func inspectImageLines(base *container.ContainerJSONBase, config *container.Config, repoDigests []string) []string {
+	lines := []string{
+		kv("Image", config.Image),
+		kv("Image ID", base.Image),
+	}
+	for _, digest := range repoDigests {
+		lines = append(lines, kv("Digest", digest))
+	}
+	return lines
+}
+
+// inspectCommandLines lists the process command line and its origin (entrypoint/cmd)
+func inspectCommandLines(base *container.ContainerJSONBase, config *container.Config) []string {
+	command := strings.TrimSpace(base.Path + " " + strings.Join(base.Args, " "))
+	lines := []string{
+		kv("Command", command),
+		kv("Entrypoint", strings.Join(config.Entrypoint, " ")),
+		kv("Cmd", strings.Join(config.Cmd, " ")),
+	}
+	if config.WorkingDir != "" {
+		lines = append(lines, kv("WorkingDir", config.WorkingDir))
+	}
+	if config.User != "" {
+		lines = append(lines, kv("User", config.User))
+	}
+	return lines
+}
+
+// inspectMountLines lists mounts as "type source → destination (mode)"
+func inspectMountLines(mounts []container.MountPoint) []string {
+	lines := []string{}
+	for _, mount := range mounts {
+		source := mount.Source
+		if mount.Name != "" {
+			source = mount.Name
+		}
+		mode := "rw"
+		if !mount.RW {
+			mode = "ro"
+		}
+		lines = append(lines, fmt.Sprintf("%-7s %s → %s (%s)", mount.Type, source, mount.Destination, mode))
+	}
+	return orNone(lines)
+}
+
+// inspectNetworkLines lists attached networks with their addresses, then published ports
+func inspectNetworkLines(settings *container.NetworkSettings) []string {
+	if settings == nil {
+		return orNone(nil)
+	}
+
+	lines := []string{}
+	names := make([]string, 0, len(settings.Networks))
+	for name := range settings.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		endpoint := settings.Networks[name]
+		if endpoint == nil {
+			continue
+		}
+		ip := endpoint.IPAddress
+		if ip != "" && endpoint.IPPrefixLen > 0 {
+			ip = fmt.Sprintf("%s/%d", ip, endpoint.IPPrefixLen)
+		}
+		lines = append(lines, kv(name, fmt.Sprintf("ip=%s gateway=%s mac=%s", ip, endpoint.Gateway, endpoint.MacAddress)))
+		if endpoint.GlobalIPv6Address != "" {
+			lines = append(lines, kv("", fmt.Sprintf("ipv6=%s/%d", endpoint.GlobalIPv6Address, endpoint.GlobalIPv6PrefixLen)))
+		}
+		if len(endpoint.Aliases) > 0 {
+			lines = append(lines, kv("", "aliases="+strings.Join(endpoint.Aliases, ",")))
+		}
+	}
+
+	ports := make([]string, 0, len(settings.Ports))
+	for port, bindings := range settings.Ports {
+		if len(bindings) == 0 {
+			ports = append(ports, string(port))
+			continue
+		}
+		for _, binding := range bindings {
+			ports = append(ports, fmt.Sprintf("%s:%s → %s", binding.HostIP, binding.HostPort, port))
+		}
+	}
+	sort.Strings(ports)
+	for _, port := range ports {
+		lines = append(lines, kv("Port", port))
+	}
+
+	return orNone(lines)
+}
+
+// inspectRestartLines lists the restart policy
+func inspectRestartLines(policy container.RestartPolicy) []string {
+	name := string(policy.Name)
+	if name == "" {
+		name = "no"
+	}
+	lines := []string{kv("Policy", name)}
+	if policy.MaximumRetryCount > 0 {
+		lines = append(lines, kv("Max Retries", fmt.Sprintf("%d", policy.MaximumRetryCount)))
+	}
+	return lines
+}
+
+// inspectLabelLines lists labels sorted by key
+func inspectLabelLines(labels map[string]string) []string {
+	lines := make([]string, 0, len(labels))
+	for key, value := range labels {
+		lines = append(lines, key+"="+value)
+	}
+	sort.Strings(lines)
+	return orNone(lines)
+}
+
+// inspectHealthLines lists the health check configuration and probe history
+func inspectHealthLines(state *container.State, check *container.HealthConfig) []string {
+	lines := []string{}
+	if check != nil && len(check.Test) > 0 {
+		lines = append(lines, kv("Test", strings.Join(check.Test, " ")))
+		if check.Interval > 0 {
+			lines = append(lines, kv("Interval", check.Interval.String()))
+		}
+	}
+	if state == nil || state.Health == nil {
+		if len(lines) == 0 {
+			return []string{"(no health check)"}
+		}
+		return lines
+	}
+
+	health := state.Health
+	lines = append(lines,
+		kv("Status", string(health.Status)),
+		kv("Failing", fmt.Sprintf("%d", health.FailingStreak)),
+	)
+	// Most recent probe first
+	for i := len(health.Log) - 1; i >= 0; i-- {
+		probe := health.Log[i]
+		if probe == nil {
+			continue
+		}
+		output := strings.TrimSpace(probe.Output)
+		if idx := strings.Index(output, "\n"); idx >= 0 {
+			output = output[:idx] + " …"
+		}
+		lines = append(lines, fmt.Sprintf("%s  exit=%d  %s", probe.Start.Format("2006-01-02 15:04:05"), probe.ExitCode, output))
+	}
+	return lines
+}
+
+// inspectResourceLines lists resource limits (0 means unlimited)
+func inspectResourceLines(resources container.Resources) []string {
+	limit := func(value int64) string {
+		if value <= 0 {
+			return "unlimited"
+		}
+		return formatBytes(uint64(value))
+	}
+
+	cpus := "unlimited"
+	if resources.NanoCPUs > 0 {
+		cpus = fmt.Sprintf("%.2f", float64(resources.NanoCPUs)/1e9)
+	}
+
+	pids := "unlimited"
+	if resources.PidsLimit != nil && *resources.PidsLimit > 0 {
+		pids = fmt.Sprintf("%d", *resources.PidsLimit)
+	}
+
+	lines := []string{
+		kv("Memory", limit(resources.Memory)),
+		kv("Memory+Swap", limit(resources.MemorySwap)),
+		kv("CPUs", cpus),
+		kv("PIDs", pids),
+	}
+	if resources.CPUShares > 0 {
+		lines = append(lines, kv("CPU Shares", fmt.Sprintf("%d", resources.CPUShares)))
+	}
+	if resources.CpusetCpus != "" {
+		lines = append(lines, kv("Cpuset", resources.CpusetCpus))
+	}
+	return lines
+}
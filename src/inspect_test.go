@@ -0,0 +1,245 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+)
+
+// sampleInspect returns an InspectResponse with every section populated
+func sampleInspect() *container.InspectResponse {
+	pids := int64(100)
+	return &container.InspectResponse{
+		ContainerJSONBase: &container.ContainerJSONBase{
+			ID:           "abc123",
+			Name:         "/web",
+			Created:      "2024-01-01T00:00:00Z",
+			Path:         "nginx",
+			Args:         []string{"-g", "daemon off;"},
+			Image:        "sha256:deadbeef",
+			RestartCount: 2,
+			State: &container.State{
+				Status:   "running",
+				Pid:      42,
+				ExitCode: 0,
+				Health: &container.Health{
+					Status:        "unhealthy",
+					FailingStreak: 3,
+					Log: []*container.HealthcheckResult{
+						{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), ExitCode: 0, Output: "ok"},
+						{Start: time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC), ExitCode: 1, Output: "connection refused\nmore"},
+					},
+				},
+			},
+			HostConfig: &container.HostConfig{
+				RestartPolicy: container.RestartPolicy{Name: "on-failure", MaximumRetryCount: 5},
+				Resources: container.Resources{
+					Memory:    512 * 1024 * 1024,
+					NanoCPUs:  1500000000,
+					PidsLimit: &pids,
+				},
+			},
+		},
+		Mounts: []container.MountPoint{
+			{Type: mount.TypeVolume, Name: "data", Destination: "/data", RW: true},
+			{Type: mount.TypeBind, Source: "/etc/conf", Destination: "/conf", RW: false},
+		},
+		Config: &container.Config{
+			Image:  "nginx:latest",
+			Env:    []string{"PATH=/usr/bin", "MODE=prod"},
+			Labels: map[string]string{"b": "2", "a": "1"},
+		},
+		NetworkSettings: &container.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"bridge": {IPAddress: "172.17.0.2", IPPrefixLen: 16, Gateway: "172.17.0.1"},
+			},
+		},
+	}
+}
+
+// findSection returns the section with the given title
+func findSection(t *testing.T, sections []inspectSection, title string) inspectSection {
+	t.Helper()
+	for _, s := range sections {
+		if s.title == title {
+			return s
+		}
+	}
+	t.Fatalf("Section %q not found", title)
+	return inspectSection{}
+}
+
+// TestBuildInspectSections tests the content of each inspect section
+func TestBuildInspectSections(t *testing.T) {
+	sections := buildInspectSections(sampleInspect(), []string{"nginx@sha256:cafe"})
+
+	tests := []struct {
+		title    string
+		contains string
+	}{
+		{"Overview", "web"},
+		{"Image", "nginx@sha256:cafe"},
+		{"Image", "sha256:deadbeef"},
+		{"Command", "nginx -g daemon off;"},
+		{"Environment", "MODE=prod"},
+		{"Mounts", "data → /data (rw)"},
+		{"Mounts", "/etc/conf → /conf (ro)"},
+		{"Networks", "172.17.0.2/16"},
+		{"Restart Policy", "on-failure"},
+		{"Labels", "a=1"},
+		{"Health", "unhealthy"},
+		{"Health", "connection refused …"},
+		{"Resources", "512.0M"},
+		{"Resources", "1.50"},
+	}
+
+	for _, tt := range tests {
+		section := findSection(t, sections, tt.title)
+		if !strings.Contains(strings.Join(section.lines, "\n"), tt.contains) {
+			t.Errorf("Section %q should contain %q, got %v", tt.title, tt.contains, section.lines)
+		}
+	}
+
+	// Labels are sorted, health log is most recent first
+	if labels := findSection(t, sections, "Labels").lines; labels[0] != "a=1" {
+		t.Errorf("Expected sorted labels, got %v", labels)
+	}
+	health := findSection(t, sections, "Health").lines
+	if !strings.Contains(health[len(health)-2], "exit=1") {
+		t.Errorf("Expected most recent probe first, got %v", health)
+	}
+}
+
+// TestBuildInspectSectionsEmpty tests that a sparse response does not panic
+func TestBuildInspectSectionsEmpty(t *testing.T) {
+	sections := buildInspectSections(&container.InspectResponse{}, nil)
+	if len(sections) != 10 {
+		t.Fatalf("Expected 10 sections, got %d", len(sections))
+	}
+	if mounts := findSection(t, sections, "Mounts").lines; mounts[0] != "(none)" {
+		t.Errorf("Expected placeholder for empty mounts, got %v", mounts)
+	}
+}
+
+// TestInspectRowsCollapse tests section collapsing and cursor placement
+func TestInspectRowsCollapse(t *testing.T) {
+	m := createTestModel()
+	m.view = inspectView
+	m.inspectCollapsed = make(map[string]bool)
+	m.inspectSections = []inspectSection{
+		{title: "One", lines: []string{"a", "b"}},
+		{title: "Two", lines: []string{"c"}},
+	}
+
+	if rows := m.inspectRows(); len(rows) != 5 {
+		t.Fatalf("Expected 5 rows when expanded, got %d", len(rows))
+	}
+
+	// Toggle from a content row collapses its section and moves the cursor to the header
+	m.inspectCursor = 2
+	m.toggleInspectSection()
+	rows := m.inspectRows()
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 rows after collapsing first section, got %d", len(rows))
+	}
+	if m.inspectCursor != 0 || !strings.HasPrefix(rows[0].text, "▶") {
+		t.Errorf("Expected cursor on collapsed header, got cursor=%d row=%q", m.inspectCursor, rows[0].text)
+	}
+
+	m.setAllInspectSections(true)
+	if rows := m.inspectRows(); len(rows) != 2 {
+		t.Errorf("Expected 2 rows when all collapsed, got %d", len(rows))
+	}
+	m.setAllInspectSections(false)
+	if rows := m.inspectRows(); len(rows) != 5 {
+		t.Errorf("Expected 5 rows when all expanded, got %d", len(rows))
+	}
+}
+
+// TestHandleKeyPress_InspectOpenAndClose tests entering and leaving the inspect view
+func TestHandleKeyPress_InspectOpenAndClose(t *testing.T) {
+	m := createTestModel()
+	m.containers = []types.Container{
+		{ID: "container-1", Names: []string{"/web"}, State: "exited"},
+	}
+
+	_, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'v'}})
+	if cmd == nil {
+		t.Fatal("Expected inspect fetch command")
+	}
+	if m.view != inspectView || !m.inspectLoading || m.inspectContainerName != "web" {
+		t.Errorf("Expected loading inspectView for web, got view=%v loading=%v name=%q", m.view, m.inspectLoading, m.inspectContainerName)
+	}
+
+	// Result for the inspected container populates the sections
+	m.Update(inspectMsg{containerID: "container-1", data: sampleInspect()})
+	if m.inspectLoading || len(m.inspectSections) == 0 {
+		t.Error("Expected sections after inspectMsg")
+	}
+
+	// Cursor movement stays within bounds
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnd})
+	if m.inspectCursor != len(m.inspectRows())-1 {
+		t.Errorf("Expected cursor on last row, got %d", m.inspectCursor)
+	}
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyDown})
+	if m.inspectCursor != len(m.inspectRows())-1 {
+		t.Errorf("Expected cursor clamped to last row, got %d", m.inspectCursor)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.view != listView || m.inspectSections != nil {
+		t.Errorf("Expected listView with cleared sections, got view=%v", m.view)
+	}
+}
+
+// TestUpdate_InspectMsgStale tests that results for another container are ignored
+func TestUpdate_InspectMsgStale(t *testing.T) {
+	m := createTestModel()
+	m.view = inspectView
+	m.inspectContainerID = "current"
+	m.inspectLoading = true
+
+	m.Update(inspectMsg{containerID: "previous", data: sampleInspect()})
+	if !m.inspectLoading || m.inspectSections != nil {
+		t.Error("Expected stale inspectMsg to be ignored")
+	}
+
+	m.Update(inspectMsg{containerID: "current", err: &testError{msg: "no such container"}})
+	if m.inspectLoading || m.inspectErr == nil {
+		t.Error("Expected inspect error to be stored")
+	}
+}
+
+// TestRenderInspect tests the inspect view rendering
+func TestRenderInspect(t *testing.T) {
+	m := createTestModel()
+	m.view = inspectView
+	m.inspectContainerName = "web"
+	m.inspectCollapsed = make(map[string]bool)
+
+	m.inspectLoading = true
+	if output := m.View(); !strings.Contains(output, "Loading") {
+		t.Error("Expected loading indicator")
+	}
+
+	m.inspectLoading = false
+	m.inspectSections = buildInspectSections(sampleInspect(), nil)
+	output := m.View()
+	for _, want := range []string{"Inspect: web", "▼ Overview", "[Q/ESC] Back"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q", want)
+		}
+	}
+
+	m.inspectErr = &testError{msg: "no such container"}
+	if output := m.View(); !strings.Contains(output, "no such container") {
+		t.Error("Expected error message in output")
+	}
+}
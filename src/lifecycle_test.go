@@ -122,7 +122,7 @@ func TestRateTrackerConsumer_OnContainerStatusChange_Running(t *testing.T) {
 	rtc := NewRateTrackerConsumer()
 
 	// Add a container with some rate data
-	rtc.OnLogLine("c1", "container1", "log line", time.Now())
+	rtc.OnLogLine("c1", "container1", "log line", streamStdout, time.Now())
 
 	if rtc.GetRate("c1") == 0 {
 		t.Error("Expected non-zero rate after adding log line")
@@ -141,8 +141,8 @@ func TestRateTrackerConsumer_OnContainerStatusChange_Stopped(t *testing.T) {
 	rtc := NewRateTrackerConsumer()
 
 	// Add a container with some rate data
-	rtc.OnLogLine("c1", "container1", "log line", time.Now())
-	rtc.OnLogLine("c1", "container1", "log line 2", time.Now())
+	rtc.OnLogLine("c1", "container1", "log line", streamStdout, time.Now())
+	rtc.OnLogLine("c1", "container1", "log line 2", streamStdout, time.Now())
 
 	if rtc.GetRate("c1") == 0 {
 		t.Error("Expected non-zero rate after adding log lines")
@@ -161,9 +161,9 @@ func TestRateTrackerConsumer_OnContainerStatusChange_MultipleContainers(t *testi
 	rtc := NewRateTrackerConsumer()
 
 	// Add multiple containers
-	rtc.OnLogLine("c1", "container1", "log", time.Now())
-	rtc.OnLogLine("c2", "container2", "log", time.Now())
-	rtc.OnLogLine("c3", "container3", "log", time.Now())
+	rtc.OnLogLine("c1", "container1", "log", streamStdout, time.Now())
+	rtc.OnLogLine("c2", "container2", "log", streamStdout, time.Now())
+	rtc.OnLogLine("c3", "container3", "log", streamStdout, time.Now())
 
 	// Stop c2
 	rtc.OnContainerStatusChange("c2", false)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -13,9 +14,24 @@ import (
 	"github.com/docker/docker/client"
 )
 
+// Log streams of a container (multiplexed frame header byte 0)
+const (
+	streamStdout = "stdout"
+	streamStderr = "stderr"
+)
+
+// logFrameStream returns the stream of a multiplexed frame from its header byte 0
+// (1 = stdout, 2 = stderr; 0 = stdin is only used by attach and reported as stdout)
+func logFrameStream(b byte) string {
+	if b == 2 {
+		return streamStderr
+	}
+	return streamStdout
+}
+
 // LogConsumer is an interface for receiving logs
 type LogConsumer interface {
-	OnLogLine(containerID, containerName, line string, timestamp time.Time)
+	OnLogLine(containerID, containerName, line, stream string, timestamp time.Time)
 	OnContainerStatusChange(containerID string, isRunning bool)
 }
 
@@ -39,6 +55,10 @@ type LogBroker struct {
 	// This prevents duplicate logs when a container restarts
 	initialFetchDone map[string]bool
 	initialFetchMu   sync.RWMutex
+
+	// Containers started with a TTY send raw (non-multiplexed) logs, cached from ContainerInspect
+	ttyContainers map[string]bool
+	ttyMu         sync.RWMutex
 }
 
 // NewLogBroker creates a new LogBroker instance
@@ -55,6 +75,7 @@ func NewLogBroker(dockerClient *client.Client) *LogBroker {
 		containers:       []types.Container{},
 		readSemaphore:    make(chan struct{}, maxConcurrentReads),
 		initialFetchDone: make(map[string]bool),
+		ttyContainers:    make(map[string]bool),
 	}
 }
 
@@ -112,6 +133,11 @@ func (lb *LogBroker) StartStreaming(containers []types.Container) {
 		// Launch goroutine AFTER releasing lock with crash protection
 		containerID := container.ID
 		containerName := container.Names[0]
+
+		// Notify consumers before the first line (streamContainer notifies the stop)
+		lb.notifyConsumers(func(c LogConsumer) {
+			c.OnContainerStatusChange(containerID, true)
+		})
 		safeGo(fmt.Sprintf("streamContainer-%s", containerName), func() {
 			lb.streamContainer(ctx, containerID, containerName)
 		})
@@ -185,11 +211,9 @@ func (lb *LogBroker) streamContainer(ctx context.Context, containerID, container
 			case <-ctx.Done():
 				return
 			case <-checkTicker.C:
-				// Check if container exists and is running every 5 seconds
-				inspectCtx, inspectCancel := context.WithTimeout(context.Background(), 2*time.Second)
-				inspect, err := lb.dockerClient.ContainerInspect(inspectCtx, containerID)
-				inspectCancel()
-				if err != nil || !inspect.State.Running {
+				// Check the container state last pushed via StartStreaming (kept current by the
+				// EventWatcher) instead of calling ContainerInspect on every reconnect attempt
+				if !lb.isContainerRunning(containerID) {
 					return
 				}
 				// Container still running, continue to streaming code below
@@ -208,6 +232,9 @@ func (lb *LogBroker) streamContainer(ctx context.Context, containerID, container
 			tailLines = "0"
 		}
 
+		// TTY containers send raw text instead of multiplexed frames
+		tty := lb.isContainerTTY(ctx, containerID)
+
 		// CRITICAL FIX: Add timeout to prevent hang if Docker daemon freezes
 		// Use 10s timeout for initial connection, then use parent context for streaming
 		logsCtx, logsCancel := context.WithTimeout(ctx, 10*time.Second)
@@ -217,6 +244,7 @@ func (lb *LogBroker) streamContainer(ctx context.Context, containerID, container
 			ShowStderr: true,
 			Follow:     true,
 			Tail:       tailLines,
+			Timestamps: true, // Docker-side time of each line (see parseLogTimestamp)
 		})
 
 		if err != nil {
@@ -252,7 +280,8 @@ func (lb *LogBroker) streamContainer(ctx context.Context, containerID, container
 		streamBroken := false
 		consecutiveTimeouts := 0
 		maxConsecutiveTimeouts := 3 // Force reconnect after 3 consecutive read timeouts
-		incompleteData := []byte{}   // Hold incomplete frames across reads
+		// Holds incomplete frames across reads
+		decoder := &logStreamDecoder{tty: tty, maxSize: maxBufSize}
 
 		for {
 			select {
@@ -359,54 +388,20 @@ func (lb *LogBroker) streamContainer(ctx context.Context, containerID, container
 			// Reset timeout counter on successful read
 			consecutiveTimeouts = 0
 
-			// CRITICAL FIX: Combine incomplete data from previous read with new data
-			data := append(incompleteData, buf[:n]...)
-			incompleteData = []byte{} // Clear incomplete buffer
-			offset := 0
-
-			// Parse multiplexed stream frames
-			for offset < len(data) {
-				// Need at least 8 bytes for header
-				if offset+8 > len(data) {
-					// Incomplete header - save for next read
-					incompleteData = data[offset:]
-					break
-				}
-
-				// Parse size (4 bytes in big-endian, unsigned)
-				size := int(data[offset+4])<<24 | int(data[offset+5])<<16 | int(data[offset+6])<<8 | int(data[offset+7])
-
-				// CRITICAL FIX: Validate size to prevent panic from negative or overflow values
-				if size < 0 || size > maxBufSize {
-					// Corrupted stream or size too large, abort this chunk
-					break
-				}
-
-				// Check if we have complete frame
-				frameEnd := offset + 8 + size
-				if frameEnd > len(data) {
-					// Incomplete frame - save for next read
-					incompleteData = data[offset:]
-
-					// CRITICAL FIX: If incomplete frame would exceed buffer size, grow buffer
-					if len(incompleteData)+minBufSize > len(buf) && len(buf) < maxBufSize {
-						newSize := min(len(buf)*2, maxBufSize)
-						buf = make([]byte, newSize)
-					}
-					break
-				}
-
-				// Complete frame available
-				payload := data[offset+8 : frameEnd]
-				line := strings.TrimRight(string(payload), "\n")
-				timestamp := time.Now()
+			// CRITICAL FIX: Decode with incomplete data from previous read
+			for _, frame := range decoder.Decode(buf[:n]) {
+				timestamp, line := parseLogTimestamp(frame.payload)
 
 				// Distribute to all consumers
 				lb.notifyConsumers(func(c LogConsumer) {
-					c.OnLogLine(containerID, containerName, line, timestamp)
+					c.OnLogLine(containerID, containerName, line, frame.stream, timestamp)
 				})
+			}
 
-				offset = frameEnd
+			// CRITICAL FIX: If incomplete frame would exceed buffer size, grow buffer
+			if decoder.Pending()+minBufSize > len(buf) && len(buf) < maxBufSize {
+				newSize := min(len(buf)*2, maxBufSize)
+				buf = make([]byte, newSize)
 			}
 		}
 
@@ -420,6 +415,19 @@ func (lb *LogBroker) streamContainer(ctx context.Context, containerID, container
 	}
 }
 
+// isContainerRunning reports whether the last known state of a container is "running"
+func (lb *LogBroker) isContainerRunning(containerID string) bool {
+	lb.containersMu.RLock()
+	defer lb.containersMu.RUnlock()
+
+	for _, c := range lb.containers {
+		if c.ID == containerID {
+			return c.State == "running"
+		}
+	}
+	return false
+}
+
 // notifyConsumers applies a function to all consumers
 func (lb *LogBroker) notifyConsumers(fn func(LogConsumer)) {
 	lb.consumersMu.RLock()
@@ -477,28 +485,73 @@ func (lb *LogBroker) StopAll() {
 	lb.initialFetchMu.Lock()
 	lb.initialFetchDone = make(map[string]bool)
 	lb.initialFetchMu.Unlock()
+
+	lb.ttyMu.Lock()
+	lb.ttyContainers = make(map[string]bool)
+	lb.ttyMu.Unlock()
+}
+
+// isContainerTTY reports whether a container was started with a TTY (-t): its logs are raw text
+// Config.Tty cannot change for a container ID, so the ContainerInspect result is cached.
+// On inspect error the stream is assumed multiplexed and the next call retries.
+func (lb *LogBroker) isContainerTTY(ctx context.Context, containerID string) bool {
+	lb.ttyMu.RLock()
+	tty, known := lb.ttyContainers[containerID]
+	lb.ttyMu.RUnlock()
+	if known {
+		return tty
+	}
+
+	inspectCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	info, err := lb.dockerClient.ContainerInspect(inspectCtx, containerID)
+	if err != nil || info.Config == nil {
+		return false
+	}
+
+	lb.ttyMu.Lock()
+	lb.ttyContainers[containerID] = info.Config.Tty
+	lb.ttyMu.Unlock()
+	return info.Config.Tty
 }
 
 // FetchRecentLogs fetches recent log lines for specific containers (oneshot, no streaming)
 func (lb *LogBroker) FetchRecentLogs(containerIDs []string, tailLines string) map[string][]string {
 	result := make(map[string][]string)
+	for containerID, entries := range lb.FetchRecentEntries(containerIDs, tailLines) {
+		lines := make([]string, len(entries))
+		for i, entry := range entries {
+			lines[i] = entry.Line
+		}
+		result[containerID] = lines
+	}
+	return result
+}
+
+// FetchRecentEntries fetches recent log lines with their Docker timestamps (oneshot, no streaming)
+// ContainerName is left empty: callers know the names of the containers they asked for.
+func (lb *LogBroker) FetchRecentEntries(containerIDs []string, tailLines string) map[string][]LogEntry {
+	result := make(map[string][]LogEntry)
 
 	for _, containerID := range containerIDs {
 		// Fetch logs (oneshot) - use closure to properly defer cancel
 		// Note: We fetch by container ID directly, no need to lookup container name
-		lines := func() []string {
+		lines := func() []LogEntry {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
 
+			tty := lb.isContainerTTY(ctx, containerID)
+
 			reader, err := lb.dockerClient.ContainerLogs(ctx, containerID, container.LogsOptions{
 				ShowStdout: true,
 				ShowStderr: true,
 				Follow:     false, // Oneshot, pas de streaming
 				Tail:       tailLines,
+				Timestamps: true,
 			})
 
 			if err != nil {
-				return []string{}
+				return []LogEntry{}
 			}
 			defer reader.Close()
 
@@ -507,59 +560,33 @@ func (lb *LogBroker) FetchRecentLogs(containerIDs []string, tailLines string) ma
 				minBufSize = 8192
 				maxBufSize = 1024 * 1024
 			)
-			lines := []string{}
+			lines := []LogEntry{}
 			buf := make([]byte, minBufSize)
-			incompleteData := []byte{}
+			decoder := &logStreamDecoder{tty: tty, maxSize: maxBufSize}
+			appendFrames := func(frames []logFrame) {
+				for _, frame := range frames {
+					timestamp, line := parseLogTimestamp(frame.payload)
+					lines = append(lines, LogEntry{ContainerID: containerID, Line: line, Stream: frame.stream, Timestamp: timestamp})
+				}
+			}
 
 			for {
 				n, err := reader.Read(buf)
+				// Read may return data along with io.EOF
+				appendFrames(decoder.Decode(buf[:n]))
 				if err != nil {
 					break
 				}
 
-				// Combine incomplete data from previous read
-				data := append(incompleteData, buf[:n]...)
-				incompleteData = []byte{}
-				offset := 0
-
-				// Parse multiplexed stream frames
-				for offset < len(data) {
-					// Need at least 8 bytes for header
-					if offset+8 > len(data) {
-						incompleteData = data[offset:]
-						break
-					}
-
-					// Parse size (4 bytes in big-endian, unsigned)
-					size := int(data[offset+4])<<24 | int(data[offset+5])<<16 | int(data[offset+6])<<8 | int(data[offset+7])
-
-					// Validate size
-					if size < 0 || size > maxBufSize {
-						break
-					}
-
-					// Check if we have complete frame
-					frameEnd := offset + 8 + size
-					if frameEnd > len(data) {
-						// Incomplete frame - save for next read
-						incompleteData = data[offset:]
-						// Grow buffer if needed
-						if len(incompleteData)+minBufSize > len(buf) && len(buf) < maxBufSize {
-							newSize := min(len(buf)*2, maxBufSize)
-							buf = make([]byte, newSize)
-						}
-						break
-					}
-
-					// Complete frame available
-					payload := data[offset+8 : frameEnd]
-					line := strings.TrimRight(string(payload), "\n")
-					lines = append(lines, line)
-
-					offset = frameEnd
+				// Grow buffer if needed
+				if decoder.Pending()+minBufSize > len(buf) && len(buf) < maxBufSize {
+					newSize := min(len(buf)*2, maxBufSize)
+					buf = make([]byte, newSize)
 				}
 			}
 
+			// TTY output may end without a newline
+			appendFrames(decoder.Flush())
 			return lines
 		}()
 
@@ -568,3 +595,120 @@ func (lb *LogBroker) FetchRecentLogs(containerIDs []string, tailLines string) ma
 
 	return result
 }
+
+// parseLogTimestamp splits the RFC3339Nano prefix added by Docker (Timestamps: true) from a log line
+// Lines without a valid prefix keep their content and get the arrival time.
+func parseLogTimestamp(line string) (time.Time, string) {
+	if idx := strings.IndexByte(line, ' '); idx > 0 {
+		if ts, err := time.Parse(time.RFC3339Nano, line[:idx]); err == nil {
+			return ts, line[idx+1:]
+		}
+	} else if ts, err := time.Parse(time.RFC3339Nano, line); err == nil {
+		return ts, "" // Empty line: timestamp only
+	}
+	return time.Now(), line
+}
+
+// logFrame is a line decoded from a container log stream
+type logFrame struct {
+	stream  string // streamStdout or streamStderr (always stdout for TTY containers)
+	payload string // Line content (with Docker timestamp prefix), without line ending
+}
+
+// logStreamDecoder splits a container log stream into lines, holding incomplete data across reads
+// Containers without TTY send multiplexed frames (8-byte header: stream type, 3 zero bytes, big-endian
+// payload size). Containers started with a TTY send raw text with \r\n line endings and no stream
+// information, which must not be parsed as frame headers.
+type logStreamDecoder struct {
+	tty     bool
+	maxSize int    // Maximum frame (or line) size
+	pending []byte // Incomplete frame or line from the previous reads
+}
+
+// Decode returns the complete lines available after appending chunk
+func (d *logStreamDecoder) Decode(chunk []byte) []logFrame {
+	data := append(d.pending, chunk...)
+	d.pending = nil
+	if d.tty {
+		return d.decodeLines(data)
+	}
+	return d.decodeFrames(data)
+}
+
+// Pending returns the number of bytes held for the next read
+func (d *logStreamDecoder) Pending() int {
+	return len(d.pending)
+}
+
+// Flush returns the last incomplete line at the end of a TTY stream (incomplete frames are dropped)
+func (d *logStreamDecoder) Flush() []logFrame {
+	pending := d.pending
+	d.pending = nil
+	if !d.tty || len(pending) == 0 {
+		return nil
+	}
+	return []logFrame{{stream: streamStdout, payload: strings.TrimRight(string(pending), "\r")}}
+}
+
+// decodeFrames parses multiplexed stream frames
+func (d *logStreamDecoder) decodeFrames(data []byte) []logFrame {
+	frames := []logFrame{}
+	offset := 0
+	for offset < len(data) {
+		// Need at least 8 bytes for header
+		if offset+8 > len(data) {
+			// Incomplete header - save for next read
+			d.pending = data[offset:]
+			break
+		}
+
+		// Parse size (4 bytes in big-endian, unsigned)
+		size := int(data[offset+4])<<24 | int(data[offset+5])<<16 | int(data[offset+6])<<8 | int(data[offset+7])
+
+		// CRITICAL FIX: Validate size to prevent panic from negative or overflow values
+		if size < 0 || size > d.maxSize {
+			// Corrupted stream or size too large, abort this chunk
+			break
+		}
+
+		// Check if we have complete frame
+		frameEnd := offset + 8 + size
+		if frameEnd > len(data) {
+			// Incomplete frame - save for next read
+			d.pending = data[offset:]
+			break
+		}
+
+		// Complete frame available
+		payload := data[offset+8 : frameEnd]
+		frames = append(frames, logFrame{
+			stream:  logFrameStream(data[offset]),
+			payload: strings.TrimRight(string(payload), "\n"),
+		})
+		offset = frameEnd
+	}
+	return frames
+}
+
+// decodeLines splits raw TTY output on newlines (\r\n or \n)
+func (d *logStreamDecoder) decodeLines(data []byte) []logFrame {
+	frames := []logFrame{}
+	for {
+		idx := bytes.IndexByte(data, '\n')
+		if idx < 0 {
+			break
+		}
+		frames = append(frames, logFrame{stream: streamStdout, payload: strings.TrimRight(string(data[:idx]), "\r")})
+		data = data[idx+1:]
+	}
+
+	// A line longer than maxSize is cut instead of growing forever
+	if len(data) > d.maxSize {
+		frames = append(frames, logFrame{stream: streamStdout, payload: string(data)})
+		data = nil
+	}
+	if len(data) > 0 {
+		d.pending = data
+	}
+	return frames
+}
@@ -2,7 +2,10 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -11,6 +14,7 @@ import (
 
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
 )
 
 // mockDockerClient is a mock implementation for testing
@@ -61,7 +65,7 @@ type mockLogConsumer struct {
 	callback func(LogEntry) // Optional callback for testing
 }
 
-func (m *mockLogConsumer) OnLogLine(containerID, containerName, line string, timestamp time.Time) {
+func (m *mockLogConsumer) OnLogLine(containerID, containerName, line, stream string, timestamp time.Time) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -69,6 +73,7 @@ func (m *mockLogConsumer) OnLogLine(containerID, containerName, line string, tim
 		ContainerID:   containerID,
 		ContainerName: containerName,
 		Line:          line,
+		Stream:        stream,
 		Timestamp:     timestamp,
 	}
 	m.logs = append(m.logs, entry)
@@ -175,7 +180,7 @@ func TestLogBrokerNotifyConsumers(t *testing.T) {
 	}
 
 	broker.notifyConsumers(func(c LogConsumer) {
-		c.OnLogLine(testLog.ContainerID, testLog.ContainerName, testLog.Line, testLog.Timestamp)
+		c.OnLogLine(testLog.ContainerID, testLog.ContainerName, testLog.Line, streamStdout, testLog.Timestamp)
 	})
 
 	// Wait a bit for goroutines to process
@@ -255,6 +260,7 @@ func TestBufferConsumerCircularBuffer(t *testing.T) {
 			"container-1",
 			"test-container",
 			"log line "+string(rune('0'+i)),
+			streamStdout,
 			time.Now(),
 		)
 	}
@@ -305,6 +311,7 @@ func TestBufferConsumerConcurrentWrites(t *testing.T) {
 					"container-1",
 					"test-container",
 					"log from goroutine "+string(rune('0'+goroutineID)),
+					streamStdout,
 					time.Now(),
 				)
 			}
@@ -340,7 +347,7 @@ func TestRateTrackerBasic(t *testing.T) {
 
 	// Add some logs
 	for i := 0; i < 10; i++ {
-		tracker.OnLogLine(containerID, "test", "line", time.Now())
+		tracker.OnLogLine(containerID, "test", "line", streamStdout, time.Now())
 	}
 
 	// Rate should be calculated (exact value depends on timing)
@@ -350,3 +357,281 @@ func TestRateTrackerBasic(t *testing.T) {
 	}
 }
 
+// TestRateTrackerErrorRate tests the per-container count of ERROR/FATAL lines
+func TestRateTrackerErrorRate(t *testing.T) {
+	tracker := NewRateTrackerConsumer()
+
+	lines := []string{
+		"INFO started",
+		"ERROR connection refused",
+		`{"level":"error","msg":"boom"}`,
+		"level=fatal msg=exiting",
+		"[warn] slow request",
+		"all good, no error here",
+	}
+	for _, line := range lines {
+		tracker.OnLogLine("c1", "api", line, streamStdout, time.Now())
+	}
+
+	if got := tracker.GetErrorRate("c1"); got != 3 {
+		t.Errorf("Expected 3 errors in the last minute, got %f", got)
+	}
+	if got := tracker.GetRate("c1"); got != float64(len(lines)) {
+		t.Errorf("Expected all %d lines in the log rate, got %f", len(lines), got)
+	}
+	if got := tracker.GetErrorRate("other"); got != 0 {
+		t.Errorf("Expected no errors for unknown container, got %f", got)
+	}
+
+	tracker.OnContainerStatusChange("c1", false)
+	if got := tracker.GetErrorRate("c1"); got != 0 {
+		t.Errorf("Expected error rate reset after stop, got %f", got)
+	}
+}
+
+
+// TestParseLogTimestamp tests splitting the Docker timestamp prefix from log lines
+func TestParseLogTimestamp(t *testing.T) {
+	ts, line := parseLogTimestamp("2025-03-01T10:20:30.123456789Z GET /health 200")
+	if line != "GET /health 200" {
+		t.Errorf("Expected line without prefix, got %q", line)
+	}
+	expected := time.Date(2025, 3, 1, 10, 20, 30, 123456789, time.UTC)
+	if !ts.Equal(expected) {
+		t.Errorf("Expected timestamp %v, got %v", expected, ts)
+	}
+
+	// Empty log line: timestamp only
+	ts, line = parseLogTimestamp("2025-03-01T10:20:30Z")
+	if line != "" || ts.IsZero() {
+		t.Errorf("Expected empty line with timestamp, got %q (%v)", line, ts)
+	}
+
+	// No prefix: line unchanged, arrival time
+	before := time.Now()
+	ts, line = parseLogTimestamp("plain line")
+	if line != "plain line" {
+		t.Errorf("Expected unchanged line, got %q", line)
+	}
+	if ts.Before(before) {
+		t.Error("Expected arrival time for lines without timestamp")
+	}
+}
+
+// TestBufferConsumerPreloadEntries tests that preloaded entries keep their timestamps
+func TestBufferConsumerPreloadEntries(t *testing.T) {
+	bc := NewBufferConsumer([]string{"c1"}, 10, nil, nil, nil)
+	ts := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	bc.PreloadEntries([]string{"c1"}, map[string][]LogEntry{
+		"c1": {{Line: "hello", Timestamp: ts}},
+	}, map[string]string{"c1": "web"})
+
+	buffer := bc.GetBuffer()
+	if len(buffer) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(buffer))
+	}
+	if buffer[0].ContainerID != "c1" || buffer[0].ContainerName != "web" || !buffer[0].Timestamp.Equal(ts) {
+		t.Errorf("Unexpected entry: %+v", buffer[0])
+	}
+}
+
+// TestMergeLogEntries tests the k-way merge by timestamp
+func TestMergeLogEntries(t *testing.T) {
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	at := func(ms int, line string) LogEntry {
+		return LogEntry{Line: line, Timestamp: base.Add(time.Duration(ms) * time.Millisecond)}
+	}
+
+	merged := mergeLogEntries([][]LogEntry{
+		{at(0, "a0"), at(30, "a30"), at(60, "a60")},
+		{at(10, "b10"), at(30, "b30")},
+		{},
+		{at(5, "c5"), at(70, "c70")},
+	})
+
+	lines := []string{}
+	for _, e := range merged {
+		lines = append(lines, e.Line)
+	}
+	// Equal timestamps keep source order (a30 before b30)
+	if got := strings.Join(lines, " "); got != "a0 c5 b10 a30 b30 a60 c70" {
+		t.Errorf("Unexpected merge order: %s", got)
+	}
+
+	if len(mergeLogEntries(nil)) != 0 {
+		t.Error("Expected empty merge for no sources")
+	}
+}
+
+// TestBufferConsumerPreloadMergesAndDedupes tests chronological preload across containers
+// and deduplication of lines received live while the tails were fetched
+func TestBufferConsumerPreloadMergesAndDedupes(t *testing.T) {
+	base := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	at := func(s int) time.Time { return base.Add(time.Duration(s) * time.Second) }
+
+	bc := NewBufferConsumer([]string{"a", "b"}, 5, nil, nil, nil)
+
+	// Live lines received before the preload: one also present in the tail of "b"
+	bc.OnLogLine("b", "web", "b3", streamStdout, at(3))
+	bc.OnLogLine("a", "api", "a5", streamStdout, at(5))
+
+	bc.PreloadEntries([]string{"a", "b"}, map[string][]LogEntry{
+		"a": {{Line: "a0", Timestamp: at(0)}, {Line: "a2", Timestamp: at(2)}},
+		"b": {{Line: "b1", Timestamp: at(1)}, {Line: "b3", Timestamp: at(3)}},
+	}, map[string]string{"a": "api", "b": "web"})
+
+	buffer := bc.GetBuffer()
+	lines := []string{}
+	for _, e := range buffer {
+		lines = append(lines, e.Line)
+	}
+	if got := strings.Join(lines, " "); got != "a0 b1 a2 b3 a5" {
+		t.Errorf("Expected chronological, deduplicated buffer, got %s", got)
+	}
+
+	// Ring keeps the newest maxLines entries and keeps working after preload
+	bc.OnLogLine("a", "api", "a6", streamStdout, at(6))
+	buffer = bc.GetBuffer()
+	if len(buffer) != 5 || buffer[0].Line != "b1" || buffer[4].Line != "a6" {
+		t.Errorf("Unexpected buffer after live line: %+v", buffer)
+	}
+}
+
+// muxFrame builds a multiplexed log frame (stream type 1 = stdout, 2 = stderr)
+func muxFrame(streamType byte, payload string) []byte {
+	size := len(payload)
+	header := []byte{streamType, 0, 0, 0, byte(size >> 24), byte(size >> 16), byte(size >> 8), byte(size)}
+	return append(header, payload...)
+}
+
+// TestLogStreamDecoderFrames tests multiplexed frames split across reads
+func TestLogStreamDecoderFrames(t *testing.T) {
+	data := append(muxFrame(1, "first\n"), muxFrame(2, "second\n")...)
+	decoder := &logStreamDecoder{maxSize: 1024}
+
+	// Split inside the second header, then inside its payload
+	frames := decoder.Decode(data[:17])
+	if len(frames) != 1 || frames[0].payload != "first" || frames[0].stream != streamStdout {
+		t.Fatalf("Expected first frame, got %+v", frames)
+	}
+	if decoder.Pending() != 3 {
+		t.Errorf("Expected 3 pending bytes, got %d", decoder.Pending())
+	}
+	if frames := decoder.Decode(data[17:20]); len(frames) != 0 {
+		t.Errorf("Expected no frame from incomplete header, got %+v", frames)
+	}
+	frames = decoder.Decode(data[20:])
+	if len(frames) != 1 || frames[0].payload != "second" || frames[0].stream != streamStderr {
+		t.Errorf("Expected second frame on stderr, got %+v", frames)
+	}
+	if len(decoder.Flush()) != 0 {
+		t.Error("Expected nothing to flush")
+	}
+}
+
+// TestLogStreamDecoderTTY tests that raw TTY output is split into lines, not parsed as frames
+func TestLogStreamDecoderTTY(t *testing.T) {
+	decoder := &logStreamDecoder{tty: true, maxSize: 1024}
+
+	// "2025-..." would be read as a frame header of a huge size by the multiplexed parser
+	frames := decoder.Decode([]byte("2025-03-01T10:20:30Z ready\r\n2025-03-01T10:20:31Z hel"))
+	if len(frames) != 1 || frames[0].payload != "2025-03-01T10:20:30Z ready" || frames[0].stream != streamStdout {
+		t.Fatalf("Expected one line without \\r, got %+v", frames)
+	}
+	frames = decoder.Decode([]byte("lo\n\nno newline"))
+	if len(frames) != 2 || frames[0].payload != "2025-03-01T10:20:31Z hello" || frames[1].payload != "" {
+		t.Errorf("Expected line joined across reads and empty line, got %+v", frames)
+	}
+	frames = decoder.Flush()
+	if len(frames) != 1 || frames[0].payload != "no newline" {
+		t.Errorf("Expected last line on flush, got %+v", frames)
+	}
+
+	// Lines longer than maxSize are cut instead of held forever
+	decoder = &logStreamDecoder{tty: true, maxSize: 8}
+	frames = decoder.Decode([]byte("0123456789"))
+	if len(frames) != 1 || decoder.Pending() != 0 {
+		t.Errorf("Expected oversized line to be emitted, got %+v (pending %d)", frames, decoder.Pending())
+	}
+}
+
+// TestFetchRecentEntriesTTY tests FetchRecentLogs against a fake Docker API with a TTY and a
+// non-TTY container
+func TestFetchRecentEntriesTTY(t *testing.T) {
+	var inspects atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tty := strings.Contains(r.URL.Path, "/containers/tty/")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/json"):
+			inspects.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"Id":"x","Config":{"Tty":%t}}`, tty)
+		case strings.HasSuffix(r.URL.Path, "/logs"):
+			if tty {
+				w.Write([]byte("2025-03-01T10:20:30Z line one\r\n2025-03-01T10:20:31Z line two\r\n"))
+			} else {
+				w.Write(muxFrame(1, "2025-03-01T10:20:30Z out\n"))
+				w.Write(muxFrame(2, "2025-03-01T10:20:31Z err\n"))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cli, err := client.NewClientWithOpts(client.WithHost("tcp://"+strings.TrimPrefix(server.URL, "http://")), client.WithVersion("1.43"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer cli.Close()
+	lb := NewLogBroker(cli)
+
+	entries := lb.FetchRecentEntries([]string{"tty", "plain"}, "10")
+	tty := entries["tty"]
+	if len(tty) != 2 || tty[0].Line != "line one" || tty[1].Line != "line two" || tty[0].Stream != streamStdout {
+		t.Errorf("Expected 2 TTY lines, got %+v", tty)
+	}
+	if !tty[0].Timestamp.Equal(time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)) {
+		t.Errorf("Expected Docker timestamp on TTY line, got %v", tty[0].Timestamp)
+	}
+	plain := entries["plain"]
+	if len(plain) != 2 || plain[0].Line != "out" || plain[1].Stream != streamStderr {
+		t.Errorf("Expected multiplexed stdout and stderr lines, got %+v", plain)
+	}
+
+	// Config.Tty is cached per container
+	lb.FetchRecentLogs([]string{"tty", "plain"}, "10")
+	if got := inspects.Load(); got != 2 {
+		t.Errorf("Expected 2 inspect calls (cached), got %d", got)
+	}
+}
+
+// statusLogConsumer records container status changes
+type statusLogConsumer struct {
+	mockLogConsumer
+	statuses []bool
+}
+
+func (s *statusLogConsumer) OnContainerStatusChange(containerID string, isRunning bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statuses = append(s.statuses, isRunning)
+}
+
+// TestLogBrokerStartedStatus tests that consumers are told when a stream starts
+func TestLogBrokerStartedStatus(t *testing.T) {
+	broker := NewLogBroker(fakeMCPServer(t).dockerClient)
+	consumer := &statusLogConsumer{}
+	broker.RegisterConsumer(consumer)
+	defer broker.StopAll()
+
+	running := types.Container{ID: "0123456789abcdef", Names: []string{"/web"}, State: "running"}
+	broker.StartStreaming([]types.Container{running})
+	broker.StartStreaming([]types.Container{running}) // Stream already active: no second event
+
+	consumer.mu.Lock()
+	defer consumer.mu.Unlock()
+	if len(consumer.statuses) != 1 || !consumer.statuses[0] {
+		t.Errorf("Expected one started status, got %v", consumer.statuses)
+	}
+}
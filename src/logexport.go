@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// logExportFormat is the file format of a logs view export (S key)
+type logExportFormat int
+
+const (
+	exportPlain logExportFormat = iota // "<time> [container] line", ANSI codes stripped
+	exportANSI                         // Same, ANSI codes of the container output kept (less -R, cat)
+	exportJSONL                        // One JSON object per line, same keys as --log-combined
+)
+
+// String returns the format name shown in the export prompt
+func (f logExportFormat) String() string {
+	switch f {
+	case exportANSI:
+		return "ansi"
+	case exportJSONL:
+		return "jsonl"
+	}
+	return "plain"
+}
+
+// extension returns the default file extension of the format
+func (f logExportFormat) extension() string {
+	switch f {
+	case exportANSI:
+		return ".ansi.log"
+	case exportJSONL:
+		return ".jsonl"
+	}
+	return ".log"
+}
+
+// next returns the following format (TAB in the export prompt)
+func (f logExportFormat) next() logExportFormat {
+	return (f + 1) % 3
+}
+
+// defaultLogExportPath returns the export file name proposed in the prompt (current directory)
+func defaultLogExportPath(format logExportFormat, now time.Time) string {
+	return "docker-tui-logs-" + now.Format("20060102-150405") + format.extension()
+}
+
+// logsExportEntries returns the buffer entries shown by the logs view: stream and level
+// filters, then the active filter (also applied in highlight mode)
+func (m *model) logsExportEntries() []LogEntry {
+	if m.bufferConsumer == nil {
+		return nil
+	}
+	entries := []LogEntry{}
+	for _, entry := range m.bufferConsumer.GetBuffer() {
+		if !m.logsEntryVisible(entry) {
+			continue
+		}
+		if entry.IsSeparator {
+			entries = append(entries, entry)
+			continue
+		}
+		line := logsViewLine{
+			text:   fmt.Sprintf("[%s] %s", m.cleanContainerName(entry.ContainerName), entry.Line),
+			fields: entry.Fields,
+		}
+		if m.logsViewLineMatchesFilter(line) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// writeLogExport writes log entries in the given format and returns the number of lines written
+// Marks (ENTER) are written as empty lines in the text formats and left out of JSONL.
+func writeLogExport(w io.Writer, entries []LogEntry, format logExportFormat, displayName func(string) string) (int, error) {
+	bw := bufio.NewWriter(w)
+	count := 0
+	for _, entry := range entries {
+		if entry.IsSeparator {
+			if format != exportJSONL {
+				bw.WriteString("\n")
+			}
+			continue
+		}
+
+		timestamp := ""
+		if !entry.Timestamp.IsZero() {
+			timestamp = entry.Timestamp.Format(time.RFC3339Nano)
+		}
+		switch format {
+		case exportJSONL:
+			record, err := json.Marshal(sinkRecord{
+				Time:        timestamp,
+				ContainerID: entry.ContainerID,
+				Container:   displayName(entry.ContainerName),
+				Stream:      entry.Stream,
+				Line:        stripAnsiCodes(entry.Line),
+			})
+			if err != nil {
+				return count, err
+			}
+			bw.Write(record)
+			bw.WriteString("\n")
+		default:
+			line := entry.Line
+			if format == exportPlain {
+				line = stripAnsiCodes(line)
+			}
+			if timestamp != "" {
+				bw.WriteString(timestamp + " ")
+			}
+			bw.WriteString("[" + displayName(entry.ContainerName) + "] " + line + "\n")
+		}
+		count++
+	}
+	return count, bw.Flush()
+}
+
+// exportLogs writes the logs view lines to path and reports the result in a toast
+// Entries are collected immediately (what is on screen now); the file is written in the background.
+func (m *model) exportLogs(path string, format logExportFormat) tea.Cmd {
+	entries := m.logsExportEntries()
+	displayName := m.cleanContainerName
+
+	return func() tea.Msg {
+		if strings.HasPrefix(path, "~/") {
+			if home, err := os.UserHomeDir(); err == nil {
+				path = filepath.Join(home, path[2:])
+			}
+		}
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+
+		// Never overwrite: an earlier export may already be attached to a ticket
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err != nil {
+			return toastMsg{message: fmt.Sprintf("export: %v", err), isError: true}
+		}
+		count, err := writeLogExport(f, entries, format, displayName)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return toastMsg{message: fmt.Sprintf("export: %v", err), isError: true}
+		}
+		return toastMsg{message: fmt.Sprintf("Exported %d lines to %s", count, path)}
+	}
+}
+
+// handleLogsExportPrompt handles keyboard input while typing the export path
+func (m *model) handleLogsExportPrompt(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.logsExportMode = false
+		m.logsExportInput = ""
+		return m, nil
+	case tea.KeyEnter:
+		path := strings.TrimSpace(m.logsExportInput)
+		m.logsExportMode = false
+		m.logsExportInput = ""
+		if path == "" {
+			return m, nil
+		}
+		return m, m.exportLogs(path, m.logsExportFormat)
+	case tea.KeyTab:
+		// Next format: the proposed extension follows unless the path was edited
+		next := m.logsExportFormat.next()
+		if strings.HasSuffix(m.logsExportInput, m.logsExportFormat.extension()) {
+			m.logsExportInput = strings.TrimSuffix(m.logsExportInput, m.logsExportFormat.extension()) + next.extension()
+		}
+		m.logsExportFormat = next
+		return m, nil
+	case tea.KeyBackspace:
+		if len(m.logsExportInput) > 0 {
+			runes := []rune(m.logsExportInput)
+			m.logsExportInput = string(runes[:len(runes)-1])
+		}
+		return m, nil
+	case tea.KeySpace:
+		m.logsExportInput += " "
+		return m, nil
+	case tea.KeyRunes:
+		m.logsExportInput += string(msg.Runes)
+		return m, nil
+	}
+	return m, nil
+}
+
+// startLogsExport opens the export prompt with a default file name
+func (m *model) startLogsExport() {
+	m.logsExportMode = true
+	m.logsExportInput = defaultLogExportPath(m.logsExportFormat, time.Now())
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// createExportTestModel returns a logs view model with a few lines of two containers
+func createExportTestModel() *model {
+	m := createTestModel()
+	m.view = logsView
+	m.height = 20
+	m.bufferConsumer = NewBufferConsumer([]string{"c1", "c2"}, 100, nil, nil, nil)
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)
+	m.bufferConsumer.OnLogLine("c1", "api", "\x1b[32mINFO\x1b[0m started", streamStdout, ts)
+	m.bufferConsumer.OnLogLine("c2", "db", "ERROR disk full", streamStderr, ts.Add(time.Second))
+	m.bufferConsumer.OnLogLine("c1", "api", "ERROR upstream timeout", streamStdout, ts.Add(2*time.Second))
+	return m
+}
+
+// TestWriteLogExportFormats tests the plain, ANSI and JSONL formats
+func TestWriteLogExportFormats(t *testing.T) {
+	m := createExportTestModel()
+	entries := m.logsExportEntries()
+
+	var sb strings.Builder
+	count, err := writeLogExport(&sb, entries, exportPlain, m.cleanContainerName)
+	if err != nil || count != 3 {
+		t.Fatalf("writeLogExport plain: count=%d err=%v", count, err)
+	}
+	want := "2025-03-01T10:20:30Z [api] INFO started\n" +
+		"2025-03-01T10:20:31Z [db] ERROR disk full\n" +
+		"2025-03-01T10:20:32Z [api] ERROR upstream timeout\n"
+	if sb.String() != want {
+		t.Errorf("Plain export = %q, want %q", sb.String(), want)
+	}
+
+	sb.Reset()
+	writeLogExport(&sb, entries, exportANSI, m.cleanContainerName)
+	if !strings.Contains(sb.String(), "[api] \x1b[32mINFO\x1b[0m started") {
+		t.Errorf("Expected ANSI codes kept, got %q", sb.String())
+	}
+
+	sb.Reset()
+	writeLogExport(&sb, entries, exportJSONL, m.cleanContainerName)
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	var record sinkRecord
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil {
+		t.Fatalf("Invalid JSONL: %v", err)
+	}
+	wantRecord := sinkRecord{Time: "2025-03-01T10:20:31Z", ContainerID: "c2", Container: "db", Stream: streamStderr, Line: "ERROR disk full"}
+	if record != wantRecord {
+		t.Errorf("Record = %+v, want %+v", record, wantRecord)
+	}
+}
+
+// TestLogsExportEntriesFilters tests that the export contains the lines shown
+func TestLogsExportEntriesFilters(t *testing.T) {
+	m := createExportTestModel()
+
+	// Highlight mode keeps every line on screen, the export applies the filter anyway
+	m.filterActive = "container:api"
+	m.logsHighlightMode = true
+	if got := len(m.logsExportEntries()); got != 2 {
+		t.Errorf("Expected 2 api lines, got %d", got)
+	}
+
+	m.filterActive = ""
+	m.logsStderrOnly = true
+	entries := m.logsExportEntries()
+	if len(entries) != 1 || entries[0].ContainerName != "db" {
+		t.Errorf("Expected the stderr line only, got %v", entries)
+	}
+
+	m.logsStderrOnly = false
+	m.logsMinLevel = levelError
+	if got := len(m.logsExportEntries()); got != 2 {
+		t.Errorf("Expected 2 ERROR lines, got %d", got)
+	}
+}
+
+// TestLogsExportPrompt tests the S prompt, format cycling and writing the file
+func TestLogsExportPrompt(t *testing.T) {
+	m := createExportTestModel()
+	dir := t.TempDir()
+
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}})
+	if !m.logsExportMode || !strings.HasSuffix(m.logsExportInput, ".log") {
+		t.Fatalf("Expected export prompt with a default .log name, got %q", m.logsExportInput)
+	}
+	if !strings.Contains(m.renderLogs(), "Save as (plain)") {
+		t.Error("Expected export prompt in view")
+	}
+
+	// TAB cycles the format and the default extension
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyTab})
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyTab})
+	if m.logsExportFormat != exportJSONL || !strings.HasSuffix(m.logsExportInput, ".jsonl") {
+		t.Fatalf("Expected jsonl format, got %v %q", m.logsExportFormat, m.logsExportInput)
+	}
+
+	path := filepath.Join(dir, "excerpt.jsonl")
+	m.logsExportInput = path
+	_, cmd := m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.logsExportMode || cmd == nil {
+		t.Fatal("Expected prompt closed and an export command")
+	}
+	msg, ok := cmd().(toastMsg)
+	if !ok || msg.isError || !strings.Contains(msg.message, "Exported 3 lines to "+path) {
+		t.Errorf("Unexpected toast %+v", msg)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Count(string(data), "\n") != 3 {
+		t.Errorf("Expected 3 JSONL lines, got %q", data)
+	}
+
+	// Existing files are not overwritten
+	msg, _ = m.exportLogs(path, exportPlain)().(toastMsg)
+	if !msg.isError {
+		t.Error("Expected an error toast for an existing file")
+	}
+
+	// ESC cancels
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'S'}})
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.logsExportMode || m.view != logsView {
+		t.Error("Expected ESC to close the prompt and stay in the logs view")
+	}
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Structured log lines: JSON objects and logfmt (key=value pairs) are parsed into fields so the
+// logs view can show level/time/msg columns (pretty mode) and filter on fields (level=error,
+// status>=500).
+
+// logFormat is the detected format of a log line
+type logFormat int
+
+const (
+	logFormatText logFormat = iota
+	logFormatJSON
+	logFormatLogfmt
+)
+
+// logField is a key/value pair of a structured log line
+// Nested JSON objects are flattened with dotted keys (http.status); arrays stay compact JSON.
+type logField struct {
+	Key   string
+	Value string
+}
+
+// logFields holds the fields of a structured log line, in line order
+type logFields struct {
+	format logFormat
+	fields []logField
+}
+
+// Well-known keys, by priority
+var (
+	logLevelKeys   = []string{"level", "lvl", "severity", "levelname", "log.level", "loglevel"}
+	logTimeKeys    = []string{"time", "ts", "timestamp", "@timestamp", "datetime", "t"}
+	logMessageKeys = []string{"msg", "message", "@message", "event", "log"}
+)
+
+// parseLogFields detects JSON or logfmt lines and returns their fields (nil for plain text)
+func parseLogFields(line string) *logFields {
+	if strings.IndexByte(line, '\x1b') >= 0 {
+		line = stripAnsiCodes(line)
+	}
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+
+	if line[0] == '{' && line[len(line)-1] == '}' {
+		if fields, ok := parseJSONLogFields(line); ok {
+			return &logFields{format: logFormatJSON, fields: fields}
+		}
+		return nil
+	}
+	if strings.IndexByte(line, '=') > 0 {
+		if fields, ok := parseLogfmtFields(line); ok {
+			return &logFields{format: logFormatLogfmt, fields: fields}
+		}
+	}
+	return nil
+}
+
+// parseJSONLogFields parses a JSON object line, keeping the key order
+func parseJSONLogFields(line string) ([]logField, bool) {
+	fields := []logField{}
+	if err := decodeJSONLogObject([]byte(line), "", &fields); err != nil {
+		return nil, false
+	}
+	return fields, true
+}
+
+// decodeJSONLogObject appends the fields of a JSON object, flattening nested objects
+func decodeJSONLogObject(data []byte, prefix string, fields *[]logField) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return fmt.Errorf("not a JSON object")
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := prefix + tok.(string) // Object keys are always strings
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		switch raw[0] {
+		case '{':
+			if err := decodeJSONLogObject(raw, key+".", fields); err != nil {
+				return err
+			}
+		case '"':
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return err
+			}
+			*fields = append(*fields, logField{Key: key, Value: s})
+		default:
+			// Numbers, booleans, null and arrays (compacted)
+			var compact bytes.Buffer
+			if err := json.Compact(&compact, raw); err != nil {
+				return err
+			}
+			*fields = append(*fields, logField{Key: key, Value: compact.String()})
+		}
+	}
+
+	if _, err := dec.Token(); err != nil { // Closing brace
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("trailing data after JSON object")
+	}
+	return nil
+}
+
+// parseLogfmtFields parses a logfmt line (key=value key="quoted value")
+// Every token must be a key=value pair and at least two pairs are required, so plain text
+// mentioning "port=8080" is not taken for logfmt.
+func parseLogfmtFields(line string) ([]logField, bool) {
+	fields := []logField{}
+	i := 0
+	for i < len(line) {
+		if line[i] == ' ' || line[i] == '\t' {
+			i++
+			continue
+		}
+
+		// Key: up to '=' (a space or quote first means plain text)
+		start := i
+		for i < len(line) && line[i] != '=' {
+			if line[i] == ' ' || line[i] == '\t' || line[i] == '"' {
+				return nil, false
+			}
+			i++
+		}
+		if i == start || i == len(line) {
+			return nil, false
+		}
+		key := line[start:i]
+		i++ // Skip '='
+
+		// Value: quoted (with escapes) or up to the next space
+		var value string
+		if i < len(line) && line[i] == '"' {
+			end := i + 1
+			for end < len(line) && line[end] != '"' {
+				if line[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(line) {
+				return nil, false // Unterminated quote
+			}
+			quoted := line[i : end+1]
+			if unquoted, err := strconv.Unquote(quoted); err == nil {
+				value = unquoted
+			} else {
+				value = quoted[1 : len(quoted)-1]
+			}
+			i = end + 1
+		} else {
+			start = i
+			for i < len(line) && line[i] != ' ' && line[i] != '\t' {
+				i++
+			}
+			value = line[start:i]
+		}
+		fields = append(fields, logField{Key: key, Value: value})
+	}
+	return fields, len(fields) >= 2
+}
+
+// Get returns the value of a field (case-insensitive key)
+func (lf *logFields) Get(key string) (string, bool) {
+	if lf == nil {
+		return "", false
+	}
+	for _, field := range lf.fields {
+		if strings.EqualFold(field.Key, key) {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// lookup returns the first well-known key present and its value
+func (lf *logFields) lookup(keys []string) (string, string) {
+	for _, key := range keys {
+		if value, ok := lf.Get(key); ok {
+			return key, value
+		}
+	}
+	return "", ""
+}
+
+// Level returns the level field value ("" when absent)
+func (lf *logFields) Level() string {
+	_, value := lf.lookup(logLevelKeys)
+	return value
+}
+
+// Time returns the time field value ("" when absent)
+func (lf *logFields) Time() string {
+	_, value := lf.lookup(logTimeKeys)
+	return value
+}
+
+// Message returns the message field value ("" when absent)
+func (lf *logFields) Message() string {
+	_, value := lf.lookup(logMessageKeys)
+	return value
+}
+
+// Rest returns the fields other than the level, time and message columns
+func (lf *logFields) Rest() []logField {
+	used := map[string]bool{}
+	for _, keys := range [][]string{logLevelKeys, logTimeKeys, logMessageKeys} {
+		if key, _ := lf.lookup(keys); key != "" {
+			used[strings.ToLower(key)] = true
+		}
+	}
+	rest := []logField{}
+	for _, field := range lf.fields {
+		if !used[strings.ToLower(field.Key)] {
+			rest = append(rest, field)
+		}
+	}
+	return rest
+}
+
+// prettyLogTime formats a time field for the pretty mode column (RFC3339 or Unix seconds/ms)
+func prettyLogTime(value string) string {
+	if value == "" {
+		return ""
+	}
+	if ts, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return ts.Local().Format("15:04:05.000")
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil && f > 0 {
+		if f > 1e12 {
+			f /= 1000 // Milliseconds
+		}
+		sec := int64(f)
+		return time.Unix(sec, int64((f-float64(sec))*1e9)).Local().Format("15:04:05.000")
+	}
+	if len(value) > logTimestampWidth {
+		return value[:logTimestampWidth]
+	}
+	return value
+}
+
+// formatPrettyLogLine formats a structured line as "LEVEL TIME msg k=v k=v"
+// The remaining fields are collapsed to fit maxWidth (0 = no limit): the ones that do not fit
+// are replaced by a "+N" count.
+func formatPrettyLogLine(lf *logFields, maxWidth int) string {
+	level := strings.ToUpper(lf.Level())
+	if len(level) > 5 {
+		level = level[:5]
+	}
+	line := fmt.Sprintf("%-5s %-*s %s", level, logTimestampWidth, prettyLogTime(lf.Time()), lf.Message())
+
+	rest := lf.Rest()
+	for i, field := range rest {
+		pair := field.Key + "=" + field.Value
+		if strings.ContainsAny(field.Value, " \t") {
+			pair = field.Key + "=" + strconv.Quote(field.Value)
+		}
+		more := ""
+		if i < len(rest)-1 {
+			more = fmt.Sprintf(" +%d", len(rest)-i-1)
+		}
+		if maxWidth > 0 && lipgloss.Width(line)+1+lipgloss.Width(pair)+len(more) > maxWidth {
+			line += fmt.Sprintf(" +%d", len(rest)-i)
+			break
+		}
+		line += " " + pair
+	}
+	return line
+}
+
+// logsPrettyWidth returns the width available for a pretty line (after the timestamp and
+// container columns), 0 when the terminal width is unknown
+func (m *model) logsPrettyWidth() int {
+	if m.width <= 0 {
+		return 0
+	}
+	width := m.width - m.logsViewMaxNameWidth - 3 // " │ "
+	if m.logsTimestampMode != timestampOff {
+		width -= logTimestampWidth + 1
+	}
+	return max(width, 20)
+}
+
+// toggleLogsPretty switches structured lines between raw and level/time/msg columns
+func (m *model) toggleLogsPretty() {
+	m.logsPrettyMode = !m.logsPrettyMode
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestParseLogFields tests JSON/logfmt detection and field order
+func TestParseLogFields(t *testing.T) {
+	tests := []struct {
+		line     string
+		format   logFormat
+		expected string // key=value pairs, "" for plain text
+	}{
+		{`{"level":"info","msg":"started","port":8080}`, logFormatJSON, "level=info msg=started port=8080"},
+		{`{"http":{"method":"GET","status":200},"tags":["a", "b"],"ok":true,"err":null}`, logFormatJSON, `http.method=GET http.status=200 tags=["a","b"] ok=true err=null`},
+		{`  {"msg":"padded"}  `, logFormatJSON, "msg=padded"},
+		{`time=2025-03-01T10:20:30Z level=WARN msg="disk \"almost\" full" pct=91`, logFormatLogfmt, `time=2025-03-01T10:20:30Z level=WARN msg=disk "almost" full pct=91`},
+		{"a= b=2", logFormatLogfmt, "a= b=2"},
+		{"server listening on port=8080", logFormatText, ""},
+		{"key=value", logFormatText, ""}, // A single pair is not enough
+		{`msg="unterminated level=info`, logFormatText, ""},
+		{`{"broken":`, logFormatText, ""},
+		{`{"a":1} trailing`, logFormatText, ""},
+		{`{"a":1}{"b":2}`, logFormatText, ""},
+		{"plain text", logFormatText, ""},
+		{"", logFormatText, ""},
+	}
+	for _, tt := range tests {
+		lf := parseLogFields(tt.line)
+		if tt.format == logFormatText {
+			if lf != nil {
+				t.Errorf("parseLogFields(%q) = %+v, want plain text", tt.line, lf.fields)
+			}
+			continue
+		}
+		if lf == nil {
+			t.Errorf("parseLogFields(%q) = nil, want format %d", tt.line, tt.format)
+			continue
+		}
+		pairs := []string{}
+		for _, field := range lf.fields {
+			pairs = append(pairs, field.Key+"="+field.Value)
+		}
+		if lf.format != tt.format || strings.Join(pairs, " ") != tt.expected {
+			t.Errorf("parseLogFields(%q) = %d %q, want %d %q", tt.line, lf.format, strings.Join(pairs, " "), tt.format, tt.expected)
+		}
+	}
+}
+
+// TestLogFieldsColumns tests the well-known level/time/msg keys
+func TestLogFieldsColumns(t *testing.T) {
+	lf := parseLogFields(`{"@timestamp":"2025-03-01T10:20:30Z","severity":"error","message":"boom","event":"db","id":7}`)
+	if lf.Level() != "error" || lf.Time() != "2025-03-01T10:20:30Z" || lf.Message() != "boom" {
+		t.Errorf("Unexpected columns: level=%q time=%q msg=%q", lf.Level(), lf.Time(), lf.Message())
+	}
+	// Only the first message key is a column
+	rest := fmt.Sprint(lf.Rest())
+	if rest != "[{event db} {id 7}]" {
+		t.Errorf("Expected event and id as remaining fields, got %s", rest)
+	}
+	if _, ok := lf.Get("ID"); !ok {
+		t.Error("Expected case-insensitive key lookup")
+	}
+}
+
+// TestPrettyLogTime tests the time column formats
+func TestPrettyLogTime(t *testing.T) {
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 125000000, time.UTC)
+	expected := ts.Local().Format("15:04:05.000")
+	for _, value := range []string{"2025-03-01T10:20:30.125Z", "1740824430.125", "1740824430125"} {
+		if got := prettyLogTime(value); got != expected {
+			t.Errorf("prettyLogTime(%q) = %q, want %q", value, got, expected)
+		}
+	}
+	if got := prettyLogTime("Mar  1 10:20:30 host"); got != "Mar  1 10:20" {
+		t.Errorf("Expected unknown formats cut to the column width, got %q", got)
+	}
+}
+
+// TestFormatPrettyLogLine tests the level/time/msg columns and collapsed fields
+func TestFormatPrettyLogLine(t *testing.T) {
+	lf := parseLogFields(`level=info msg="user logged in" user=bob ip=10.0.0.1 agent="curl 8.0"`)
+
+	got := formatPrettyLogLine(lf, 0)
+	expected := `INFO               user logged in user=bob ip=10.0.0.1 agent="curl 8.0"`
+	if got != expected {
+		t.Errorf("formatPrettyLogLine() = %q, want %q", got, expected)
+	}
+
+	// Fields that do not fit are counted
+	got = formatPrettyLogLine(lf, 45)
+	if got != "INFO               user logged in user=bob +2" {
+		t.Errorf("Expected collapsed fields, got %q", got)
+	}
+}
+
+// TestLogsPrettyMode tests the P toggle in the logs view
+func TestLogsPrettyMode(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	m.width = 120
+	m.height = 20
+	m.logsViewBuffer = []string{
+		`[api] {"level":"error","msg":"upstream failed","status":502}`,
+		"[api] plain line",
+	}
+
+	if !strings.Contains(m.renderLogs(), `{"level":"error"`) {
+		t.Fatal("Expected raw JSON before pretty mode")
+	}
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'p'}})
+	if !m.logsPrettyMode {
+		t.Fatal("Expected pretty mode after P")
+	}
+	output := m.renderLogs()
+	if !strings.Contains(output, "ERROR") || !strings.Contains(output, "upstream failed status=502") {
+		t.Error("Expected level/msg columns and remaining fields in pretty mode")
+	}
+	if !strings.Contains(output, "plain line") || !strings.Contains(output, "[pretty]") {
+		t.Error("Expected plain lines unchanged and [pretty] indicator")
+	}
+
+	// Field queries use the parsed fields
+	m.filterActive = "status>=500"
+	if got := m.getFilteredLogCount(); got != 1 {
+		t.Errorf("Expected 1 line matching status>=500, got %d", got)
+	}
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Logs view filter language:
+//
+//	error timeout           both terms (AND)
+//	error | panic           either group (OR, also "OR")
+//	error -healthcheck      exclude lines containing a term
+//	container:api error     only lines of containers whose name contains "api" (also "c:")
+//	"connection refused"    quoted phrase
+//	level=error status>=500 field query on JSON/logfmt lines (=, !=, >, >=, <, <=)
+//
+// Terms are case-insensitive substrings, or regular expressions when regex mode is on.
+// Field values are compared as numbers when both sides are numeric; on plain text lines a
+// key=value query is searched as text.
+
+// logFilterTerm is one condition of a logs filter
+type logFilterTerm struct {
+	negate    bool           // -term: the line must NOT match
+	container bool           // container:name: matches the container name instead of the line
+	text      string         // Lowercase substring (substring mode or invalid regex)
+	re        *regexp.Regexp // Compiled term (regex mode)
+	highlight *regexp.Regexp // Pattern colored in highlight mode (nil for exclusions and container terms)
+	field     string         // Field query key ("" for text terms)
+	op        string         // Field query operator
+	value     string         // Field query value
+}
+
+// logFieldQueryRe matches field queries: key, operator, value
+var logFieldQueryRe = regexp.MustCompile(`^([A-Za-z_@][\w.@-]*)(!=|>=|<=|=|>|<)(.*)$`)
+
+// logFilter is a parsed logs filter: OR of AND groups
+type logFilter struct {
+	input  string
+	regex  bool
+	groups [][]logFilterTerm
+	err    error // First invalid regex (such terms fall back to substring search)
+	fields bool  // True when a term is a field query (lines must be parsed)
+}
+
+// tokenizeLogFilter splits a filter on whitespace, keeping quoted phrases together
+func tokenizeLogFilter(input string) []string {
+	tokens := []string{}
+	var current strings.Builder
+	inQuotes := false
+	hasToken := false
+
+	for _, r := range input {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			hasToken = true
+		case unicode.IsSpace(r) && !inQuotes:
+			if hasToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				hasToken = false
+			}
+		default:
+			current.WriteRune(r)
+			hasToken = true
+		}
+	}
+	if hasToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// parseLogFilter parses the logs filter input
+func parseLogFilter(input string, regex bool) *logFilter {
+	f := &logFilter{input: input, regex: regex}
+	group := []logFilterTerm{}
+
+	for _, token := range tokenizeLogFilter(input) {
+		if token == "|" || token == "OR" {
+			if len(group) > 0 {
+				f.groups = append(f.groups, group)
+				group = []logFilterTerm{}
+			}
+			continue
+		}
+
+		term := logFilterTerm{}
+		if len(token) > 1 && strings.HasPrefix(token, "-") {
+			term.negate = true
+			token = token[1:]
+		}
+		lower := strings.ToLower(token)
+		for _, prefix := range []string{"container:", "c:"} {
+			if strings.HasPrefix(lower, prefix) {
+				term.container = true
+				token = token[len(prefix):]
+				break
+			}
+		}
+		if token == "" {
+			continue // "container:" being typed
+		}
+
+		term.text = strings.ToLower(token)
+		pattern := token
+		if !term.container {
+			if query := logFieldQueryRe.FindStringSubmatch(token); query != nil {
+				if query[3] == "" {
+					continue // "level=" being typed
+				}
+				term.field, term.op, term.value = query[1], query[2], query[3]
+				pattern = term.value
+				f.fields = true
+			}
+		}
+		if regex {
+			re, err := regexp.Compile("(?i)" + pattern)
+			if err != nil {
+				if f.err == nil {
+					f.err = err
+				}
+			} else {
+				term.re = re
+			}
+		}
+		if !term.negate && !term.container && (term.field == "" || term.op == "=") {
+			term.highlight = term.re
+			if term.highlight == nil {
+				term.highlight = regexp.MustCompile("(?i)" + regexp.QuoteMeta(pattern))
+			}
+		}
+		group = append(group, term)
+	}
+	if len(group) > 0 {
+		f.groups = append(f.groups, group)
+	}
+	return f
+}
+
+// matches reports whether a log line of the given container matches the term
+func (t logFilterTerm) matches(containerName, line string, fields *logFields) bool {
+	if t.field != "" {
+		return t.matchesField(line, fields) != t.negate
+	}
+
+	target := line
+	if t.container {
+		target = containerName
+	}
+
+	var hit bool
+	if t.re != nil {
+		hit = t.re.MatchString(target)
+	} else {
+		hit = strings.Contains(strings.ToLower(target), t.text)
+	}
+	return hit != t.negate
+}
+
+// matchesField evaluates a field query (without negation)
+func (t logFilterTerm) matchesField(line string, fields *logFields) bool {
+	if fields == nil {
+		// Plain text line: key=value is searched as text
+		return t.op == "=" && strings.Contains(strings.ToLower(line), t.text)
+	}
+	value, ok := fields.Get(t.field)
+	if !ok {
+		return t.op == "!="
+	}
+
+	equal := func() bool {
+		if t.re != nil {
+			return t.re.MatchString(value)
+		}
+		return strings.EqualFold(value, t.value)
+	}
+	a, errA := strconv.ParseFloat(value, 64)
+	b, errB := strconv.ParseFloat(t.value, 64)
+	numeric := errA == nil && errB == nil
+	cmp := strings.Compare(strings.ToLower(value), strings.ToLower(t.value))
+	if numeric {
+		cmp = 0
+		if a < b {
+			cmp = -1
+		} else if a > b {
+			cmp = 1
+		}
+	}
+
+	switch t.op {
+	case "=":
+		return (numeric && cmp == 0) || equal()
+	case "!=":
+		return !((numeric && cmp == 0) || equal())
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// Match reports whether a "[container] content" log line passes the filter
+// Fields are parsed from the content only when the filter has field queries.
+func (f *logFilter) Match(line string) bool {
+	var fields *logFields
+	if f.fields {
+		fields = parseLogFields(logLineContent(stripAnsiCodes(line)))
+	}
+	return f.MatchFields(line, fields)
+}
+
+// MatchFields reports whether a "[container] content" log line with already parsed fields
+// (nil for plain text) passes the filter
+func (f *logFilter) MatchFields(line string, fields *logFields) bool {
+	if len(f.groups) == 0 {
+		return true
+	}
+
+	// Strip ANSI codes before searching to avoid false negatives/positives
+	cleanLine := stripAnsiCodes(line)
+	containerName := ""
+	if strings.HasPrefix(cleanLine, "[") {
+		if end := strings.Index(cleanLine, "]"); end > 0 {
+			containerName = cleanLine[1:end]
+		}
+	}
+
+	for _, group := range f.groups {
+		matched := true
+		for _, term := range group {
+			if !term.matches(containerName, cleanLine, fields) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// logLineContent returns the content of a "[container] content" line
+func logLineContent(line string) string {
+	if strings.HasPrefix(line, "[") {
+		if end := strings.Index(line, "] "); end > 0 {
+			return line[end+2:]
+		}
+	}
+	return line
+}
+
+// activeLogFilter returns the parsed logs filter, re-parsing when the input or mode changed
+func (m *model) activeLogFilter() *logFilter {
+	if m.logFilter == nil || m.logFilter.input != m.filterActive || m.logFilter.regex != m.logsFilterRegex {
+		m.logFilter = parseLogFilter(m.filterActive, m.logsFilterRegex)
+	}
+	return m.logFilter
+}
+
+// toggleLogsFilterRegex switches the logs filter between substring and regex mode
+func (m *model) toggleLogsFilterRegex() {
+	m.logsFilterRegex = !m.logsFilterRegex
+	m.logsMatchKey = nil
+	// Scroll to bottom to see latest filtered results
+	m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
+	m.updateWasAtBottom()
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestTokenizeLogFilter tests splitting with quoted phrases
+func TestTokenizeLogFilter(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"error", "error"},
+		{"  error   -healthcheck ", "error|-healthcheck"},
+		{`"connection refused" api`, "connection refused|api"},
+		{`-"health check"`, "-health check"},
+		{"error | panic", "error|||panic"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := strings.Join(tokenizeLogFilter(tt.input), "|"); got != tt.expected {
+			t.Errorf("tokenizeLogFilter(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+// TestLogFilterMatch tests the logs filter language
+func TestLogFilterMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		regex  bool
+		line   string
+		want   bool
+	}{
+		{"empty filter", "", false, "[api] anything", true},
+		{"substring", "error", false, "[api] ERROR: boom", true},
+		{"substring miss", "error", false, "[api] all good", false},
+		{"and", "error timeout", false, "[api] error: timeout", true},
+		{"and miss", "error timeout", false, "[api] error: refused", false},
+		{"exclusion", "error -healthcheck", false, "[api] error in healthcheck", false},
+		{"exclusion keeps others", "error -healthcheck", false, "[api] error in handler", true},
+		{"or first", "panic | fatal", false, "[api] panic: nil map", true},
+		{"or second", "panic OR fatal", false, "[api] fatal error", true},
+		{"or miss", "panic | fatal", false, "[api] warning", false},
+		{"container scope", "container:api error", false, "[my-api-1] error", true},
+		{"container scope miss", "container:db error", false, "[my-api-1] error", false},
+		{"container short prefix", "c:API", false, "[my-api-1] hello", true},
+		{"container exclusion", "-container:api", false, "[my-api-1] hello", false},
+		{"quoted phrase", `"connection refused"`, false, "[db] connection refused by peer", true},
+		{"quoted phrase miss", `"connection refused"`, false, "[db] refused connection", false},
+		{"literal in substring mode", "a.c", false, "[x] abc", false},
+		{"regex", "^\\[db\\] err(or)?\\b", true, "[db] err something", true},
+		{"regex case insensitive", "err.*time", true, "[db] ERROR timeout", true},
+		{"regex exclusion", "error -health.*check", true, "[db] error health-check", false},
+		{"invalid regex falls back to substring", "[invalid", true, "[x] value [invalid here", true},
+		{"ansi stripped", "error", false, "[x] \x1b[31merror\x1b[0m", true},
+		{"lone dash is literal", "-", false, "[x] a - b", true},
+		{"empty container term ignored", "container:", false, "[x] anything", true},
+		{"field equals json", "level=error", false, `[x] {"level":"ERROR","msg":"boom"}`, true},
+		{"field equals miss", "level=error", false, `[x] {"level":"info","msg":"error"}`, false},
+		{"field numeric compare", "status>=500", false, `[x] {"status":503}`, true},
+		{"field numeric compare miss", "status>=500", false, `[x] {"status":404}`, false},
+		{"field numeric not lexical", "status<1000", false, "[x] status=999 path=/", true},
+		{"field logfmt", "user=bob", false, `[x] level=info user=bob msg="logged in"`, true},
+		{"field nested", "http.status>400", false, `[x] {"http":{"status":502}}`, true},
+		{"field missing", "status>=500", false, `[x] {"level":"info"}`, false},
+		{"field not equal missing", "env!=prod", false, `[x] {"level":"info"}`, true},
+		{"field negated", "-level=debug", false, "[x] level=debug msg=hi", false},
+		{"field text fallback", "port=8080", false, "[x] server listening on port=8080", true},
+		{"field compare on text", "status>=500", false, "[x] status 503", false},
+		{"field regex value", "level=err.*", true, `[x] {"level":"error"}`, true},
+		{"field being typed ignored", "level=", false, "[x] anything", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := parseLogFilter(tt.filter, tt.regex)
+			if got := f.Match(tt.line); got != tt.want {
+				t.Errorf("parseLogFilter(%q, %v).Match(%q) = %v, want %v", tt.filter, tt.regex, tt.line, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLogFilterInvalidRegex tests that invalid patterns are reported (filter bar highlighting)
+func TestLogFilterInvalidRegex(t *testing.T) {
+	if parseLogFilter("error [invalid", true).err == nil {
+		t.Error("Expected error for invalid regex term")
+	}
+	if parseLogFilter("error [invalid", false).err != nil {
+		t.Error("Expected no error in substring mode")
+	}
+	if parseLogFilter("err(or)? -health", true).err != nil {
+		t.Error("Expected no error for valid regex terms")
+	}
+}
+
+// TestLogsFilterRegexToggle tests the regex toggle and filter cache in logs view
+func TestLogsFilterRegexToggle(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	m.filterActive = "a.c"
+
+	if m.logLineMatchesFilter("[x] abc") {
+		t.Error("Expected literal match in substring mode")
+	}
+
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	if !m.logsFilterRegex {
+		t.Fatal("Expected regex mode after R")
+	}
+	if !m.logLineMatchesFilter("[x] abc") {
+		t.Error("Expected regex match after toggle")
+	}
+
+	// Ctrl+R toggles while typing the filter
+	m.filterMode = true
+	m.handleFilterMode(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if m.logsFilterRegex {
+		t.Error("Expected substring mode after Ctrl+R")
+	}
+	if !m.filterMode {
+		t.Error("Expected to stay in filter mode")
+	}
+
+	// Invalid regex highlights the filter bar
+	m.logsFilterRegex = true
+	m.filterInput = "[invalid"
+	m.filterActive = m.filterInput
+	if !strings.Contains(m.renderFilterBar(), "regex") {
+		t.Error("Expected regex mode in filter bar")
+	}
+	if m.activeLogFilter().err == nil {
+		t.Error("Expected invalid filter")
+	}
+}
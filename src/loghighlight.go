@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Highlight mode: all log lines stay visible, filter terms are colored and n/N jump between
+// matching lines
+
+var (
+	logHighlightStyle        = lipgloss.NewStyle().Background(lipgloss.Color("#d7af00")).Foreground(lipgloss.Color("#000000"))
+	logCurrentHighlightStyle = lipgloss.NewStyle().Background(lipgloss.Color("#ff8700")).Foreground(lipgloss.Color("#000000")).Bold(true)
+)
+
+// Spans returns the [start, end) byte ranges of text matched by the filter terms, merged and sorted
+// Exclusions and container terms are not highlighted.
+func (f *logFilter) Spans(text string) [][2]int {
+	spans := [][2]int{}
+	for _, group := range f.groups {
+		for _, term := range group {
+			if term.highlight == nil {
+				continue
+			}
+			for _, loc := range term.highlight.FindAllStringIndex(text, -1) {
+				if loc[1] > loc[0] {
+					spans = append(spans, [2]int{loc[0], loc[1]})
+				}
+			}
+		}
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	merged := [][2]int{spans[0]}
+	for _, span := range spans[1:] {
+		last := &merged[len(merged)-1]
+		if span[0] <= last[1] {
+			last[1] = max(last[1], span[1])
+			continue
+		}
+		merged = append(merged, span)
+	}
+	return merged
+}
+
+// highlightLogContent colors the filter matches of a log line content
+// Matches are searched in the ANSI-stripped text (same handling as stripAnsiCodes) and mapped
+// back to the raw string, so escape sequences are never cut. Text outside matches is rendered
+// with base (nil = unstyled).
+func highlightLogContent(raw string, f *logFilter, base *lipgloss.Style, hl lipgloss.Style) string {
+	render := func(s string) string {
+		if base == nil || s == "" {
+			return s
+		}
+		return base.Render(s)
+	}
+
+	clean, offsets := splitAnsiCodes(raw)
+	spans := f.Spans(clean)
+	if len(spans) == 0 {
+		return render(raw)
+	}
+
+	var sb strings.Builder
+	pos := 0 // Raw offset of the next byte to write
+	for _, span := range spans {
+		start := offsets[span[0]]
+		end := offsets[span[1]-1] + 1
+		sb.WriteString(render(raw[pos:start]))
+		// Escape sequences inside the match are dropped: the highlight must stay readable
+		sb.WriteString(hl.Render(stripAnsiCodes(raw[start:end])))
+		pos = end
+	}
+	sb.WriteString(render(raw[pos:]))
+	return sb.String()
+}
+
+// isLogMatchLine reports whether a logs view line counts as a match in highlight mode
+func (m *model) isLogMatchLine(line logsViewLine) bool {
+	return !strings.HasPrefix(line.text, "[SEPARATOR] ") && m.logsViewLineMatchesFilter(line)
+}
+
+// logMatchLines returns the indices of the lines matching the filter in highlight mode
+func (m *model) logMatchLines(lines []logsViewLine) []int {
+	matches := []int{}
+	if m.filterActive == "" {
+		return matches
+	}
+	for i, line := range lines {
+		if m.isLogMatchLine(line) {
+			matches = append(matches, i)
+		}
+	}
+	return matches
+}
+
+// logsViewLine is a line of the logs view
+type logsViewLine struct {
+	text      string      // "[container] content" ("[SEPARATOR] " marks separators)
+	timestamp time.Time   // Docker time of the line (zero for the fallback buffer)
+	stream    string      // streamStdout or streamStderr ("" when unknown)
+	fields    *logFields  // Parsed JSON/logfmt fields (nil for plain text)
+	level     logLevel    // Detected level (levelUnknown when none)
+	key       logEntryKey // Identifies the entry while lines arrive or leave the buffer
+}
+
+// logsViewLines returns the logs view lines
+// In stderr-only mode, lines of other streams are left out, and lines below the minimum level
+// (including lines without a level) when one is set. Separators are kept.
+func (m *model) logsViewLines() []logsViewLine {
+	if m.bufferConsumer == nil {
+		// Fallback si pas de consumer (no stream information)
+		lines := []logsViewLine{}
+		if m.logsStderrOnly {
+			return lines
+		}
+		for i, text := range m.logsViewBuffer {
+			line := logsViewLine{text: text, fields: parseLogFields(logLineContent(text)), key: logEntryKey{timestamp: int64(i), line: text}}
+			line.level = detectLogLevel(logLineContent(text), line.fields)
+			if strings.HasPrefix(text, "[SEPARATOR] ") || line.level >= m.logsMinLevel {
+				lines = append(lines, line)
+			}
+		}
+		return lines
+	}
+	entries := m.bufferConsumer.GetBuffer()
+	lines := make([]logsViewLine, 0, len(entries))
+	for _, entry := range entries {
+		if !m.logsEntryVisible(entry) {
+			continue
+		}
+		line := logsViewLine{timestamp: entry.Timestamp, stream: entry.Stream, fields: entry.Fields, level: entry.Level, key: entry.key()}
+		if entry.IsSeparator {
+			// Use special marker for separators to identify them during rendering
+			line.text = "[SEPARATOR] " + entry.Line
+		} else {
+			displayName := m.cleanContainerName(entry.ContainerName)
+			line.text = fmt.Sprintf("[%s] %s", displayName, entry.Line)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// logsEntryVisible reports whether a buffer entry passes the stderr-only and minimum level modes
+func (m *model) logsEntryVisible(entry LogEntry) bool {
+	if entry.IsSeparator {
+		return true
+	}
+	if m.logsStderrOnly && entry.Stream != streamStderr {
+		return false
+	}
+	return entry.Level >= m.logsMinLevel
+}
+
+// toggleLogsHighlight switches between hiding non-matching lines and highlighting matches
+func (m *model) toggleLogsHighlight() {
+	m.logsHighlightMode = !m.logsHighlightMode
+	m.logsMatchKey = nil
+	// Scroll to bottom: the number of visible lines changed
+	m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
+	m.updateWasAtBottom()
+}
+
+// jumpToLogMatch moves to the next (or previous) matching line in highlight mode, wrapping around
+func (m *model) jumpToLogMatch(forward bool) {
+	if !m.logsHighlightMode || m.filterActive == "" {
+		return
+	}
+	lines := m.logsViewLines()
+	matches := m.logMatchLines(lines)
+	if len(matches) == 0 {
+		return
+	}
+
+	// Without a current match (or when its entry left the buffer), start from the visible window
+	pageLines := m.height - 5
+	current := m.logsMatchIndex(lines)
+	if current < 0 {
+		current = m.logsViewScroll - 1
+		if !forward {
+			current = m.logsViewScroll + pageLines
+		}
+	}
+
+	var target int
+	if forward {
+		idx := sort.SearchInts(matches, current+1)
+		if idx == len(matches) {
+			idx = 0
+		}
+		target = matches[idx]
+	} else {
+		idx := sort.SearchInts(matches, current) - 1
+		if idx < 0 {
+			idx = len(matches) - 1
+		}
+		target = matches[idx]
+	}
+
+	// Center the match in the window
+	key := lines[target].key
+	m.logsMatchKey = &key
+	maxScroll := max(0, m.getFilteredLogCount()-pageLines)
+	m.logsViewScroll = min(maxScroll, max(0, target-pageLines/2))
+	m.updateWasAtBottom()
+}
+
+// logsMatchIndex returns the index of the current match in lines (-1 = none)
+func (m *model) logsMatchIndex(lines []logsViewLine) int {
+	if m.logsMatchKey == nil {
+		return -1
+	}
+	for i := len(lines) - 1; i >= 0; i-- {
+		if lines[i].key == *m.logsMatchKey {
+			return i
+		}
+	}
+	return -1
+}
+
+// logMatchPosition returns the 1-based position of the current match (0 = none)
+func logMatchPosition(matches []int, line int) int {
+	idx := sort.SearchInts(matches, line)
+	if line >= 0 && idx < len(matches) && matches[idx] == line {
+		return idx + 1
+	}
+	return 0
+}
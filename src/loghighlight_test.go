@@ -0,0 +1,208 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestSplitAnsiCodes tests the clean text to raw offset mapping
+func TestSplitAnsiCodes(t *testing.T) {
+	raw := "a\x1b[31mbc\x1b[0md"
+	clean, offsets := splitAnsiCodes(raw)
+	if clean != "abcd" {
+		t.Fatalf("Expected clean text 'abcd', got %q", clean)
+	}
+	expected := []int{0, 6, 7, 12}
+	if fmt.Sprint(offsets) != fmt.Sprint(expected) {
+		t.Errorf("Expected offsets %v, got %v", expected, offsets)
+	}
+	if stripAnsiCodes(raw) != clean {
+		t.Error("Expected stripAnsiCodes to match splitAnsiCodes")
+	}
+}
+
+// TestLogFilterSpans tests highlight ranges of filter terms
+func TestLogFilterSpans(t *testing.T) {
+	tests := []struct {
+		filter   string
+		regex    bool
+		text     string
+		expected string
+	}{
+		{"error", false, "Error and error", "[[0 5] [10 15]]"},
+		{"err error", false, "error", "[[0 5]]"},                  // Overlapping terms merged
+		{"error -health", false, "error health", "[[0 5]]"},       // Exclusions not highlighted
+		{"container:api x", false, "api x", "[[4 5]]"},            // Container terms not highlighted
+		{"panic | fatal", false, "fatal panic", "[[0 5] [6 11]]"}, // All OR groups
+		{"a.c", false, "abc a.c", "[[4 7]]"},                      // Literal in substring mode
+		{"a.c", true, "abc a.c", "[[0 3] [4 7]]"},
+		{"x*", true, "abc", "[]"}, // Empty matches ignored
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprint(parseLogFilter(tt.filter, tt.regex).Spans(tt.text)); got != tt.expected {
+			t.Errorf("Spans(%q, %q) = %s, want %s", tt.filter, tt.text, got, tt.expected)
+		}
+	}
+}
+
+// TestHighlightLogContent tests that highlighting keeps escape sequences intact
+func TestHighlightLogContent(t *testing.T) {
+	hl := lipgloss.NewStyle().Bold(true)
+	f := parseLogFilter("error", false)
+
+	raw := "level=\x1b[31merror\x1b[0m msg=boom"
+	got := highlightLogContent(raw, f, nil, hl)
+	if stripAnsiCodes(got) != stripAnsiCodes(raw) {
+		t.Errorf("Expected same visible text, got %q", stripAnsiCodes(got))
+	}
+	if !strings.HasPrefix(got, "level=\x1b[31m") || !strings.Contains(got, "\x1b[0m msg=boom") {
+		t.Errorf("Expected escape sequences around the match to be kept, got %q", got)
+	}
+	if !strings.Contains(got, hl.Render("error")) {
+		t.Errorf("Expected highlighted match, got %q", got)
+	}
+
+	// No match: unchanged
+	if got := highlightLogContent("all good", f, nil, hl); got != "all good" {
+		t.Errorf("Expected unchanged line, got %q", got)
+	}
+}
+
+// TestLogsHighlightMode tests that all lines stay visible and n/N cycle through matches
+func TestLogsHighlightMode(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	m.height = 10 // 5 lines per page
+	m.logsViewBuffer = []string{}
+	for i := 0; i < 30; i++ {
+		line := fmt.Sprintf("[api] line %d", i)
+		if i == 3 || i == 15 || i == 27 {
+			line = fmt.Sprintf("[api] ERROR %d", i)
+		}
+		m.logsViewBuffer = append(m.logsViewBuffer, line)
+	}
+	m.filterActive = "error"
+
+	if got := m.getFilteredLogCount(); got != 3 {
+		t.Fatalf("Expected 3 filtered lines, got %d", got)
+	}
+
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'h'}})
+	if !m.logsHighlightMode {
+		t.Fatal("Expected highlight mode after H")
+	}
+	if got := m.getFilteredLogCount(); got != 30 {
+		t.Errorf("Expected all 30 lines visible in highlight mode, got %d", got)
+	}
+
+	// n from the top of the window
+	m.logsViewScroll = 0
+	if m.logsMatchIndex(m.logsViewLines()) != -1 {
+		t.Error("Expected no current match before n/N")
+	}
+	current := func() int { return m.logsMatchIndex(m.logsViewLines()) }
+	next := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}}
+	prev := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}}
+
+	m.handleLogsViewKeys(next)
+	if current() != 3 {
+		t.Errorf("Expected first match at line 3, got %d", current())
+	}
+	m.handleLogsViewKeys(next)
+	if current() != 15 {
+		t.Errorf("Expected second match at line 15, got %d", current())
+	}
+	if m.logsViewScroll > 15 || m.logsViewScroll+5 <= 15 {
+		t.Errorf("Expected match line 15 visible, scroll=%d", m.logsViewScroll)
+	}
+	m.handleLogsViewKeys(next)
+	m.handleLogsViewKeys(next)
+	if current() != 3 {
+		t.Errorf("Expected wrap to first match, got %d", current())
+	}
+	m.handleLogsViewKeys(prev)
+	if current() != 27 {
+		t.Errorf("Expected wrap back to last match, got %d", current())
+	}
+
+	// Match counter in the status bar
+	output := m.renderLogs()
+	if !strings.Contains(output, "match 3/3") {
+		t.Error("Expected match counter 3/3 in status bar")
+	}
+
+	// Back to hiding non-matching lines
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'H'}})
+	if m.logsHighlightMode || m.logsMatchKey != nil {
+		t.Error("Expected highlight mode off and no current match")
+	}
+}
+
+// TestLogsMatchFollowsEntry tests that the current match stays on its line while lines arrive
+// or leave the buffer, and is reset when the filter or a view mode changes
+func TestLogsMatchFollowsEntry(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	m.height = 10
+	m.bufferConsumer = NewBufferConsumer([]string{"c1"}, 5, nil, nil, nil)
+	now := time.Now()
+	add := func(i int, line string) {
+		m.bufferConsumer.OnLogLine("c1", "api", line, streamStdout, now.Add(time.Duration(i)*time.Millisecond))
+	}
+	add(0, "ok")
+	add(1, "ERROR first")
+	add(2, "ok")
+	add(3, "ERROR second")
+	m.filterActive = "error"
+	m.logsHighlightMode = true
+
+	// Nothing is drawn as the current match before n/N
+	if strings.Contains(m.renderLogs(), "match 1/") {
+		t.Error("Expected no current match before n/N")
+	}
+
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'N'}})
+	currentLine := func() string {
+		lines := m.logsViewLines()
+		if i := m.logsMatchIndex(lines); i >= 0 {
+			return lines[i].text
+		}
+		return ""
+	}
+	if currentLine() != "[api] ERROR second" {
+		t.Fatalf("Expected the last match, got %q", currentLine())
+	}
+
+	// New lines push the ring buffer: the match stays on its entry
+	add(4, "ok")
+	add(5, "ok")
+	add(6, "ok")
+	if currentLine() != "[api] ERROR second" {
+		t.Errorf("Expected the match to follow its entry, got %q", currentLine())
+	}
+	if !strings.Contains(m.renderLogs(), "match 1/1") {
+		t.Error("Expected match counter 1/1 once the first match left the buffer")
+	}
+
+	// Modes changing the visible lines reset the match
+	m.toggleLogsStderrOnly()
+	m.toggleLogsStderrOnly()
+	if m.logsMatchKey != nil {
+		t.Error("Expected the match to be reset by the stderr mode")
+	}
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m.toggleLogsTimestamps()
+	if m.logsMatchKey != nil {
+		t.Error("Expected the match to be reset by the timestamp mode")
+	}
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	m.compileFilter("second")
+	if m.logsMatchKey != nil {
+		t.Error("Expected the match to be reset by a filter change")
+	}
+}
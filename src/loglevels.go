@@ -0,0 +1,166 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// logLevel is the severity detected for a log line
+type logLevel int
+
+const (
+	levelUnknown logLevel = iota // No level found (stack traces, plain output)
+	levelTrace
+	levelDebug
+	levelInfo
+	levelWarn
+	levelError
+	levelFatal
+)
+
+// String returns the level name shown in the logs view
+func (l logLevel) String() string {
+	switch l {
+	case levelTrace:
+		return "TRACE"
+	case levelDebug:
+		return "DEBUG"
+	case levelInfo:
+		return "INFO"
+	case levelWarn:
+		return "WARN"
+	case levelError:
+		return "ERROR"
+	case levelFatal:
+		return "FATAL"
+	}
+	return ""
+}
+
+// nextMinLevel returns the following minimum level (L key): all → DEBUG → INFO → WARN → ERROR → all
+func (l logLevel) nextMinLevel() logLevel {
+	switch l {
+	case levelUnknown:
+		return levelDebug
+	case levelError, levelFatal:
+		return levelUnknown
+	}
+	return l + 1
+}
+
+// logLevelNames maps level names and abbreviations (lowercase) to levels
+var logLevelNames = map[string]logLevel{
+	"trace": levelTrace, "trc": levelTrace, "verbose": levelTrace,
+	"debug": levelDebug, "dbg": levelDebug,
+	"info": levelInfo, "inf": levelInfo, "information": levelInfo, "notice": levelInfo,
+	"warn": levelWarn, "warning": levelWarn, "wrn": levelWarn,
+	"error": levelError, "err": levelError, "eror": levelError, "severe": levelError,
+	"fatal": levelFatal, "ftl": levelFatal, "critical": levelFatal, "crit": levelFatal,
+	"panic": levelFatal, "emerg": levelFatal, "emergency": levelFatal, "alert": levelFatal,
+}
+
+// parseLogLevelName converts a level field value (name or pino/bunyan number) to a level
+func parseLogLevelName(value string) logLevel {
+	if level, ok := logLevelNames[strings.ToLower(strings.TrimSpace(value))]; ok {
+		return level
+	}
+	if n, err := strconv.Atoi(value); err == nil {
+		// pino/bunyan: 10 trace, 20 debug, 30 info, 40 warn, 50 error, 60 fatal
+		switch {
+		case n >= 60:
+			return levelFatal
+		case n >= 50:
+			return levelError
+		case n >= 40:
+			return levelWarn
+		case n >= 30:
+			return levelInfo
+		case n >= 20:
+			return levelDebug
+		case n >= 10:
+			return levelTrace
+		}
+	}
+	return levelUnknown
+}
+
+// Level patterns of unstructured lines, tried in order
+var (
+	// JSON or logfmt level key inside a line that was not parsed (prefix, partial JSON)
+	logLevelKeyRe = regexp.MustCompile(`(?i)"?\b(?:level|lvl|severity|levelname)"?\s*[:=]\s*"?([a-z]+|\d+)`)
+	// klog/glog: I0301 10:20:30.123456 (Go, Kubernetes components)
+	logKlogRe = regexp.MustCompile(`^([IWEF])\d{4} \d{2}:\d{2}:\d{2}`)
+	// Upper-case level word: [ERROR], ERROR:root: (Python), "... ERROR 1234 --- [main]" (Java), "WARN[0000]"
+	logLevelWordRe = regexp.MustCompile(`\b(TRACE|DEBUG|DBG|INFO|INF|NOTICE|WARN|WARNING|WRN|ERROR|ERR|FATAL|FTL|CRITICAL|CRIT|PANIC|SEVERE)\b`)
+	// Bracketed lower-case level: [error] (nginx), <warn>
+	logLevelBracketRe = regexp.MustCompile(`(?i)[\[<](trace|debug|info|notice|warn|warning|error|err|fatal|crit|critical|emerg|alert)[\]>]`)
+)
+
+// logLevelScanWidth limits the text patterns to the start of the line (levels appear early,
+// a message mentioning "ERROR" later should not change the level)
+const logLevelScanWidth = 120
+
+// detectLogLevel classifies a log line using its structured fields (when parsed) or common
+// text patterns: level keys, klog prefixes, upper-case level words and bracketed levels
+func detectLogLevel(line string, fields *logFields) logLevel {
+	if fields != nil {
+		if value := fields.Level(); value != "" {
+			return parseLogLevelName(value)
+		}
+	}
+
+	if strings.IndexByte(line, '\x1b') >= 0 {
+		line = stripAnsiCodes(line)
+	}
+	line = strings.TrimSpace(line)
+	if len(line) > logLevelScanWidth {
+		line = line[:logLevelScanWidth]
+	}
+
+	if match := logLevelKeyRe.FindStringSubmatch(line); match != nil {
+		if level := parseLogLevelName(match[1]); level != levelUnknown {
+			return level
+		}
+	}
+	if match := logKlogRe.FindStringSubmatch(line); match != nil {
+		return map[string]logLevel{"I": levelInfo, "W": levelWarn, "E": levelError, "F": levelFatal}[match[1]]
+	}
+	word := logLevelWordRe.FindStringSubmatchIndex(line)
+	bracket := logLevelBracketRe.FindStringSubmatchIndex(line)
+	switch {
+	case word != nil && (bracket == nil || word[0] <= bracket[0]):
+		return parseLogLevelName(line[word[2]:word[3]])
+	case bracket != nil:
+		return parseLogLevelName(line[bracket[2]:bracket[3]])
+	}
+	if strings.HasPrefix(line, "panic: ") || strings.HasPrefix(line, "Traceback (most recent call last)") {
+		return levelFatal
+	}
+	return levelUnknown
+}
+
+// isErrorLevel reports whether a level counts in the per-container error rate
+func (l logLevel) isErrorLevel() bool {
+	return l >= levelError
+}
+
+// logLevelColors are the foreground colors of log lines by level (INFO and unknown keep the default)
+var logLevelColors = map[logLevel]lipgloss.Color{
+	levelTrace: lipgloss.Color("#6c6c6c"),
+	levelDebug: lipgloss.Color("#8a8a8a"),
+	levelWarn:  lipgloss.Color("#ffd75f"),
+	levelError: lipgloss.Color("#ff5f5f"),
+	levelFatal: lipgloss.Color("#ff00af"),
+}
+
+// toggleLogsMinLevel cycles the minimum level shown in the logs view
+func (m *model) toggleLogsMinLevel() {
+	m.logsMinLevel = m.logsMinLevel.nextMinLevel()
+	m.logsMatchKey = nil
+	// Scroll to bottom: the number of visible lines changed
+	m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
+	m.updateWasAtBottom()
+}
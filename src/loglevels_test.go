@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestDetectLogLevel tests level detection on common log formats
+func TestDetectLogLevel(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected logLevel
+	}{
+		{`{"level":"warn","msg":"slow"}`, levelWarn},
+		{`{"level":50,"msg":"pino error"}`, levelError},
+		{`{"severity":"CRITICAL","message":"down"}`, levelFatal},
+		{`time=2025-03-01T10:20:30Z level=DEBUG msg="cache miss"`, levelDebug},
+		{"2025-03-01 10:20:30 [ERROR] Connection refused", levelError},
+		{"ERROR:root:division by zero", levelError},                                              // Python logging
+		{"2025-03-01 10:20:30,123 - app - WARNING - disk almost full", levelWarn},                // Python format
+		{"2025-03-01 10:20:30.123  INFO 1234 --- [main] o.s.b.Application : Started", levelInfo}, // Spring Boot
+		{"10:20:30.123 [main] DEBUG com.example.Service - loading", levelDebug},                  // logback
+		{"E0301 10:20:30.123456       1 reflector.go:138] failed to list", levelError},           // klog
+		{"I0301 10:20:30.123456       1 main.go:42] ready", levelInfo},
+		{"2025/03/01 10:20:30 [error] 29#29: *1 open() failed", levelError}, // nginx
+		{"[2025-03-01T10:20:30Z TRACE app] entering", levelTrace},           // env_logger
+		{"WARN[0000] deprecated option", levelWarn},                         // logrus text
+		{"INFO request done, ERROR count=0", levelInfo},                     // First level wins
+		{"panic: runtime error: index out of range", levelFatal},
+		{"Traceback (most recent call last):", levelFatal},
+		{"\x1b[31mERROR\x1b[0m colored output", levelError},
+		{"an error occurred", levelUnknown}, // Lower-case words are not levels
+		{"    at com.example.Main.run(Main.java:42)", levelUnknown},
+		{"", levelUnknown},
+	}
+	for _, tt := range tests {
+		if got := detectLogLevel(tt.line, parseLogFields(tt.line)); got != tt.expected {
+			t.Errorf("detectLogLevel(%q) = %v, want %v", tt.line, got, tt.expected)
+		}
+	}
+
+	// Without parsed fields, JSON levels are still found by the text patterns
+	if got := detectLogLevel(`{"msg":"x","level":"error"}`, nil); got != levelError {
+		t.Errorf("Expected ERROR from JSON text, got %v", got)
+	}
+}
+
+// TestNextMinLevel tests the L key cycle
+func TestNextMinLevel(t *testing.T) {
+	level := levelUnknown
+	names := []string{}
+	for i := 0; i < 5; i++ {
+		level = level.nextMinLevel()
+		names = append(names, level.String())
+	}
+	if got := strings.Join(names, ","); got != "DEBUG,INFO,WARN,ERROR," {
+		t.Errorf("Unexpected min level cycle: %s", got)
+	}
+}
+
+// TestLogsMinLevel tests hiding lines below the minimum level in the logs view
+func TestLogsMinLevel(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	m.height = 20
+	m.logsViewBuffer = []string{
+		"[api] DEBUG cache miss",
+		"[api] INFO request done",
+		"[api] WARN slow query",
+		"[SEPARATOR] ",
+		"[api] ERROR upstream failed",
+		"[api]     at handler.go:42",
+	}
+
+	if got := m.getFilteredLogCount(); got != 6 {
+		t.Fatalf("Expected all 6 lines, got %d", got)
+	}
+
+	key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}}
+	m.handleLogsViewKeys(key) // DEBUG
+	m.handleLogsViewKeys(key) // INFO
+	m.handleLogsViewKeys(key) // WARN
+	if m.logsMinLevel != levelWarn {
+		t.Fatalf("Expected WARN minimum level, got %v", m.logsMinLevel)
+	}
+	lines := m.logsViewLines()
+	if len(lines) != 3 || lines[0].text != "[api] WARN slow query" || lines[2].level != levelError {
+		t.Errorf("Expected WARN line, separator and ERROR line, got %+v", lines)
+	}
+	if !strings.Contains(m.renderLogs(), "[≥WARN]") {
+		t.Error("Expected minimum level indicator in status bar")
+	}
+
+	m.handleLogsViewKeys(key) // ERROR
+	m.handleLogsViewKeys(key) // all
+	if m.logsMinLevel != levelUnknown || m.getFilteredLogCount() != 6 {
+		t.Error("Expected all lines after a full cycle")
+	}
+}
+
+// TestFormatErrorRateValue tests the ERR/MIN value
+func TestFormatErrorRateValue(t *testing.T) {
+	if got := stripAnsiCodes(formatErrorRateValue(0)); got != "    0" {
+		t.Errorf("Expected padded 0, got %q", got)
+	}
+	if got := stripAnsiCodes(formatErrorRateValue(12)); got != "   12" {
+		t.Errorf("Expected padded 12, got %q", got)
+	}
+	if got := stripAnsiCodes(formatErrorRateValue(123456)); got != " 9999" {
+		t.Errorf("Expected capped value, got %q", got)
+	}
+}
@@ -0,0 +1,27 @@
+package main
+
+import "github.com/charmbracelet/lipgloss"
+
+// Stream of a log line: stderr lines are marked in the logs view and can be shown alone
+
+// logStderrMarker replaces the "│" separator after the container name on stderr lines
+const logStderrMarker = "┃"
+
+var logStderrMarkerStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#ff5f5f")).Bold(true)
+
+// logSeparatorFor returns the separator drawn after the container name for a stream
+func logSeparatorFor(stream string) string {
+	if stream == streamStderr {
+		return logStderrMarker
+	}
+	return "│"
+}
+
+// toggleLogsStderrOnly switches between all lines and stderr lines only
+func (m *model) toggleLogsStderrOnly() {
+	m.logsStderrOnly = !m.logsStderrOnly
+	m.logsMatchKey = nil
+	// Scroll to bottom: the number of visible lines changed
+	m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
+	m.updateWasAtBottom()
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestLogFrameStream tests the stream type byte of multiplexed log frames
+func TestLogFrameStream(t *testing.T) {
+	tests := []struct {
+		b        byte
+		expected string
+	}{
+		{1, streamStdout},
+		{2, streamStderr},
+		{0, streamStdout}, // stdin (never sent for logs)
+	}
+	for _, tt := range tests {
+		if got := logFrameStream(tt.b); got != tt.expected {
+			t.Errorf("logFrameStream(%d) = %q, want %q", tt.b, got, tt.expected)
+		}
+	}
+}
+
+// TestLogsStderrOnly tests the E toggle and the stderr separator of the logs view
+func TestLogsStderrOnly(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	m.height = 20
+	m.bufferConsumer = NewBufferConsumer([]string{"c1"}, 100, nil, nil, nil)
+	now := time.Now()
+	m.bufferConsumer.OnLogLine("c1", "api", "listening on :8080", streamStdout, now)
+	m.bufferConsumer.OnLogLine("c1", "api", "connection refused", streamStderr, now.Add(time.Millisecond))
+	m.bufferConsumer.InsertSeparator()
+	m.bufferConsumer.OnLogLine("c1", "api", "request done", streamStdout, now.Add(2*time.Millisecond))
+
+	if got := m.getFilteredLogCount(); got != 4 {
+		t.Fatalf("Expected 4 lines, got %d", got)
+	}
+	output := m.renderLogs()
+	if !strings.Contains(output, logStderrMarker+" connection refused") {
+		t.Error("Expected stderr line marked with the stderr separator")
+	}
+	if strings.Contains(output, logStderrMarker+" listening") {
+		t.Error("Expected stdout line without the stderr separator")
+	}
+
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'e'}})
+	if !m.logsStderrOnly {
+		t.Fatal("Expected stderr-only mode after E")
+	}
+	lines := m.logsViewLines()
+	if len(lines) != 2 || lines[0].text != "[api] connection refused" || lines[0].stream != streamStderr {
+		t.Errorf("Expected stderr line and separator only, got %v", lines)
+	}
+	if !strings.Contains(m.renderLogs(), "[stderr]") {
+		t.Error("Expected [stderr] indicator in status bar")
+	}
+
+	// Filter applies on top of the stream
+	m.filterActive = "refused"
+	if got := m.getFilteredLogCount(); got != 1 {
+		t.Errorf("Expected 1 filtered stderr line, got %d", got)
+	}
+
+	m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	m.filterActive = ""
+	if m.logsStderrOnly || m.getFilteredLogCount() != 4 {
+		t.Error("Expected all lines after second E")
+	}
+}
+
+// TestFormatMCPLogLine tests stderr marking in get_logs output
+func TestFormatMCPLogLine(t *testing.T) {
+	stderr := LogEntry{Line: "boom", Stream: streamStderr}
+	stdout := LogEntry{Line: "ok", Stream: streamStdout}
+
+	if got := formatMCPLogLine(stderr, ""); got != "[stderr] boom" {
+		t.Errorf("Expected marked stderr line, got %q", got)
+	}
+	if got := formatMCPLogLine(stdout, ""); got != "ok" {
+		t.Errorf("Expected unmarked stdout line, got %q", got)
+	}
+	// A single stream was requested: no marker needed
+	if got := formatMCPLogLine(stderr, streamStderr); got != "boom" {
+		t.Errorf("Expected unmarked line for stream=stderr, got %q", got)
+	}
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// logTimestampMode is the timestamp column shown in the logs view
+type logTimestampMode int
+
+const (
+	timestampOff      logTimestampMode = iota
+	timestampAbsolute                  // 15:04:05.000 (local time)
+	timestampRelative                  // Age of the line (2m03s)
+	timestampDelta                     // Time since the previous visible line (+0.125s)
+)
+
+// logTimestampWidth is the width of the timestamp column (without the separator)
+const logTimestampWidth = 12
+
+var logTimestampStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#808080"))
+
+// next returns the following mode (T key): off → absolute → relative → delta → off
+func (mode logTimestampMode) next() logTimestampMode {
+	return (mode + 1) % (timestampDelta + 1)
+}
+
+// label returns the name shown in the logs view status bar
+func (mode logTimestampMode) label() string {
+	switch mode {
+	case timestampAbsolute:
+		return "time"
+	case timestampRelative:
+		return "age"
+	case timestampDelta:
+		return "delta"
+	}
+	return ""
+}
+
+// formatLogTimestamp formats the timestamp column of a log line, padded to logTimestampWidth
+// prev is the timestamp of the previous visible line (zero when none).
+func formatLogTimestamp(mode logTimestampMode, ts, prev, now time.Time) string {
+	text := ""
+	if !ts.IsZero() {
+		switch mode {
+		case timestampAbsolute:
+			text = ts.Local().Format("15:04:05.000")
+		case timestampRelative:
+			text = formatLogDuration(now.Sub(ts))
+		case timestampDelta:
+			if !prev.IsZero() {
+				text = "+" + formatLogDuration(ts.Sub(prev))
+			}
+		}
+	}
+	return fmt.Sprintf("%*s", logTimestampWidth, text)
+}
+
+// formatLogDuration formats a duration compactly: 0.125s, 42.0s, 2m03s, 1h05m, 3d04h
+func formatLogDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-" // Clock skew between hosts/containers
+		d = -d
+	}
+	switch {
+	case d < 10*time.Second:
+		return fmt.Sprintf("%s%.3fs", sign, d.Seconds())
+	case d < time.Minute:
+		return fmt.Sprintf("%s%.1fs", sign, d.Seconds())
+	case d < time.Hour:
+		return fmt.Sprintf("%s%dm%02ds", sign, int(d.Minutes()), int(d.Seconds())%60)
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%s%dh%02dm", sign, int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%s%dd%02dh", sign, int(d.Hours())/24, int(d.Hours())%24)
+}
+
+// toggleLogsTimestamps cycles the timestamp column mode
+func (m *model) toggleLogsTimestamps() {
+	m.logsTimestampMode = m.logsTimestampMode.next()
+	m.logsMatchKey = nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestFormatLogDuration tests compact durations of the timestamp column
+func TestFormatLogDuration(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{125 * time.Millisecond, "0.125s"},
+		{42 * time.Second, "42.0s"},
+		{2*time.Minute + 3*time.Second, "2m03s"},
+		{time.Hour + 5*time.Minute, "1h05m"},
+		{76 * time.Hour, "3d04h"},
+		{-500 * time.Millisecond, "-0.500s"},
+	}
+	for _, tt := range tests {
+		if got := formatLogDuration(tt.d); got != tt.expected {
+			t.Errorf("formatLogDuration(%v) = %q, want %q", tt.d, got, tt.expected)
+		}
+	}
+}
+
+// TestFormatLogTimestamp tests the absolute, relative and delta columns
+func TestFormatLogTimestamp(t *testing.T) {
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 250000000, time.Local)
+	prev := ts.Add(-1500 * time.Millisecond)
+	now := ts.Add(2 * time.Minute)
+
+	if got := formatLogTimestamp(timestampAbsolute, ts, prev, now); got != "10:20:30.250" {
+		t.Errorf("Absolute: got %q", got)
+	}
+	if got := formatLogTimestamp(timestampRelative, ts, prev, now); strings.TrimSpace(got) != "2m00s" {
+		t.Errorf("Relative: got %q", got)
+	}
+	if got := formatLogTimestamp(timestampDelta, ts, prev, now); strings.TrimSpace(got) != "+1.500s" {
+		t.Errorf("Delta: got %q", got)
+	}
+
+	// First line has no delta, unknown timestamps are blank; width is constant
+	for _, got := range []string{
+		formatLogTimestamp(timestampDelta, ts, time.Time{}, now),
+		formatLogTimestamp(timestampAbsolute, time.Time{}, prev, now),
+	} {
+		if strings.TrimSpace(got) != "" || len(got) != logTimestampWidth {
+			t.Errorf("Expected blank padded column, got %q", got)
+		}
+	}
+}
+
+// TestLogsTimestampToggle tests cycling the timestamp column with T
+func TestLogsTimestampToggle(t *testing.T) {
+	m := createTestModel()
+	m.view = logsView
+	key := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'t'}}
+
+	expected := []logTimestampMode{timestampAbsolute, timestampRelative, timestampDelta, timestampOff}
+	for _, mode := range expected {
+		m.handleLogsViewKeys(key)
+		if m.logsTimestampMode != mode {
+			t.Errorf("Expected mode %d, got %d", mode, m.logsTimestampMode)
+		}
+	}
+
+	m.logsTimestampMode = timestampAbsolute
+	m.logsViewBuffer = []string{"[web] hello"}
+	if output := m.renderLogs(); !strings.Contains(output, "[time]") {
+		t.Error("Expected timestamp mode in status bar")
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"syscall"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/client"
 )
 
 func main() {
@@ -23,18 +24,33 @@ func main() {
 		}
 	}()
 
+	// Offline viewer: docker-tui view <files...>
+	if len(os.Args) > 1 && os.Args[1] == "view" {
+		runViewer(os.Args[2:])
+		return
+	}
+
 	// Parse command line arguments
 	demoMode := false
 	debugMonitor := false
 	logsBufferLength := 10000
 	mcpServerMode := false
 	mcpPort := 9876
+	mcpExec := false
+	mcpExecAllow := []string{}
+	mcpExecPolicy := NewMCPExecPolicy()
+	doubleClickShell := false
+	contextNames := []string{}
+	hostURLs := []string{}
+	errorPatternValues := []string{}
+	fileSinkOpts := FileSinkOptions{MaxSize: 100 << 20, MaxFiles: 10}
 	for i, arg := range os.Args[1:] {
 		switch arg {
 		case "--help", "-h":
 			fmt.Println("Docker TUI - Terminal User Interface for Docker")
 			fmt.Println()
 			fmt.Println("Usage: docker-tui [OPTIONS]")
+			fmt.Println("       docker-tui view FILE [FILE...]   Open saved log files (no Docker daemon needed)")
 			fmt.Println()
 			fmt.Println("Options:")
 			fmt.Println("  --demo                      Hide container name prefixes (removes text up to first underscore)")
@@ -42,6 +58,18 @@ func main() {
 			fmt.Println("  --logs-buffer-length SIZE   Maximum log lines in buffer (default: 10000)")
 			fmt.Println("  --mcp-server                Enable MCP HTTP server alongside TUI (default port: 9876)")
 			fmt.Println("  --mcp-port PORT             Set MCP server port (default: 9876)")
+			fmt.Println("  --mcp-exec                  Enable the MCP exec_command tool (needs --mcp-exec-allow)")
+			fmt.Println("  --mcp-exec-allow RULE       Allow commands in containers: PATTERN=PREFIX, e.g. 'api-*=cat /etc/', 'db=pg_isready *' (repeatable)")
+			fmt.Println("  --mcp-exec-timeout DURATION Time limit of exec_command commands (default: 10s)")
+			fmt.Println("  --double-click ACTION       Double-click action in list: logs or shell (default: logs)")
+			fmt.Println("  --context NAME[,NAME...]    Docker context(s) to connect to (repeatable)")
+			fmt.Println("  --host URL[,URL...]         Docker daemon(s): unix://, tcp://, ssh://user@host (repeatable)")
+			fmt.Println("  --error-pattern REGEX       Count matching lines as errors in ERR/MIN (repeatable)")
+			fmt.Println("  --log-dir DIR               Persist container logs to DIR (one file per container)")
+			fmt.Println("  --log-combined              Write one combined JSONL file instead (with --log-dir)")
+			fmt.Println("  --log-max-size MB           Rotate log files larger than MB (default: 100, 0: off)")
+			fmt.Println("  --log-max-age DURATION      Rotate log files older than DURATION, e.g. 24h (default: off)")
+			fmt.Println("  --log-max-files N           Rotated (gzipped) files kept per log file (default: 10, 0: all)")
 			fmt.Println("  --help, -h                  Show this help message")
 			fmt.Println()
 			fmt.Println("Examples:")
@@ -51,6 +79,11 @@ func main() {
 			fmt.Println("  docker-tui --logs-buffer-length 50000         Use 50k lines buffer")
 			fmt.Println("  docker-tui --mcp-server                       Run with MCP HTTP server on port 9876 (v1.4.0+)")
 			fmt.Println("  docker-tui --mcp-server --mcp-port 9000       Run with MCP server on custom port")
+			fmt.Println("  docker-tui --mcp-server --mcp-exec --mcp-exec-allow '*=curl -s localhost/health'  Let the assistant query health endpoints")
+			fmt.Println("  docker-tui --context staging1,staging2        Monitor two Docker contexts (H to switch)")
+			fmt.Println("  docker-tui --host ssh://deploy@10.0.0.5       Monitor a remote daemon over ssh")
+			fmt.Println("  docker-tui --error-pattern 'exception|5\\d\\d '  Also count exceptions and 5xx as errors")
+			fmt.Println("  docker-tui --log-dir ~/docker-logs --log-max-age 24h  Keep container logs on disk")
 			fmt.Println()
 			fmt.Println("Keyboard Shortcuts:")
 			fmt.Println("  List View:")
@@ -60,12 +93,18 @@ func main() {
 			fmt.Println("    Ctrl+A             Select running containers")
 			fmt.Println("    X                  Clear selection")
 			fmt.Println("    I                  Invert selection")
+			fmt.Println("    G                  Group by compose project")
+			fmt.Println("    ←/→                Collapse/expand project")
 			fmt.Println("    ENTER, L           View logs")
 			fmt.Println("    S                  Start container(s)")
 			fmt.Println("    P                  Stop container(s)")
 			fmt.Println("    R                  Restart container(s)")
 			fmt.Println("    U                  Pause/Unpause container(s)")
 			fmt.Println("    D                  Remove container(s)")
+			fmt.Println("    E                  Open shell in container")
+			fmt.Println("    V                  Inspect container")
+			fmt.Println("    TAB, Shift+TAB     Images / volumes / networks views")
+			fmt.Println("    H                  Switch host (multi-host)")
 			fmt.Println("    /                  Filter containers")
 			fmt.Println("    Q, ESC             Quit")
 			fmt.Println()
@@ -74,7 +113,38 @@ func main() {
 			fmt.Println("    PgUp/PgDn          Page up/down")
 			fmt.Println("    Home/End           Jump to top/bottom")
 			fmt.Println("    ENTER              Insert timestamp mark")
-			fmt.Println("    /                  Filter logs")
+			fmt.Println("    T                  Timestamps: absolute / relative / delta / off")
+			fmt.Println("    E                  Show stderr lines only")
+			fmt.Println("    P                  Pretty mode for JSON/logfmt lines")
+			fmt.Println("    L                  Minimum level: all / DEBUG / INFO / WARN / ERROR")
+			fmt.Println("    S                  Save shown lines to a file (plain, ANSI or JSONL)")
+			fmt.Println("    /                  Filter logs (-term excludes, a | b, container:name, level=error)")
+			fmt.Println("    R                  Toggle regex filter")
+			fmt.Println("    H                  Toggle highlight mode (n/N: next/previous match)")
+			fmt.Println("    Q, ESC             Back to list")
+			fmt.Println()
+			fmt.Println("  Inspect View:")
+			fmt.Println("    ↑/↓, k/j           Move cursor")
+			fmt.Println("    ENTER, SPACE       Collapse/expand section")
+			fmt.Println("    +/-                Expand/collapse all")
+			fmt.Println("    R                  Refresh")
+			fmt.Println("    Q, ESC             Back to list")
+			fmt.Println()
+			fmt.Println("  Images View:")
+			fmt.Println("    SPACE              Toggle selection")
+			fmt.Println("    U                  Pull image")
+			fmt.Println("    D                  Remove image(s)")
+			fmt.Println("    P                  Prune dangling images")
+			fmt.Println("    R                  Refresh")
+			fmt.Println("    Q, ESC             Back to list")
+			fmt.Println()
+			fmt.Println("  Volumes / Networks Views:")
+			fmt.Println("    SPACE              Toggle selection")
+			fmt.Println("    C                  Connect selected containers to network")
+			fmt.Println("    U                  Disconnect selected containers from network")
+			fmt.Println("    D                  Remove volume(s) / network(s)")
+			fmt.Println("    P                  Prune unused")
+			fmt.Println("    R                  Refresh")
 			fmt.Println("    Q, ESC             Back to list")
 			fmt.Println()
 			os.Exit(0)
@@ -95,21 +165,159 @@ func main() {
 			if i+1 < len(os.Args[1:]) {
 				fmt.Sscanf(os.Args[i+2], "%d", &mcpPort)
 			}
+		case "--mcp-exec":
+			mcpExec = true
+		case "--mcp-exec-allow":
+			if i+1 < len(os.Args[1:]) {
+				mcpExecAllow = append(mcpExecAllow, os.Args[i+2])
+			}
+		case "--mcp-exec-timeout":
+			if i+1 < len(os.Args[1:]) {
+				timeout, err := time.ParseDuration(os.Args[i+2])
+				if err != nil || timeout <= 0 {
+					fmt.Printf("Invalid --mcp-exec-timeout: %s\n", os.Args[i+2])
+					os.Exit(1)
+				}
+				mcpExecPolicy.Timeout = timeout
+			}
+		case "--double-click":
+			if i+1 < len(os.Args[1:]) {
+				switch os.Args[i+2] {
+				case "logs":
+					doubleClickShell = false
+				case "shell":
+					doubleClickShell = true
+				default:
+					fmt.Printf("Invalid --double-click: %s (expected logs or shell)\n", os.Args[i+2])
+					os.Exit(1)
+				}
+			}
+		case "--context":
+			if i+1 < len(os.Args[1:]) {
+				contextNames = append(contextNames, splitFlagValues(os.Args[i+2])...)
+			}
+		case "--host":
+			if i+1 < len(os.Args[1:]) {
+				hostURLs = append(hostURLs, splitFlagValues(os.Args[i+2])...)
+			}
+		case "--error-pattern":
+			if i+1 < len(os.Args[1:]) {
+				errorPatternValues = append(errorPatternValues, os.Args[i+2])
+			}
+		case "--log-dir":
+			if i+1 < len(os.Args[1:]) {
+				fileSinkOpts.Dir = os.Args[i+2]
+			}
+		case "--log-combined":
+			fileSinkOpts.Combined = true
+		case "--log-max-size":
+			if i+1 < len(os.Args[1:]) {
+				sizeMB, err := strconv.ParseInt(os.Args[i+2], 10, 64)
+				if err != nil || sizeMB < 0 {
+					fmt.Printf("Invalid --log-max-size: %s (expected a number of MB)\n", os.Args[i+2])
+					os.Exit(1)
+				}
+				fileSinkOpts.MaxSize = sizeMB << 20
+			}
+		case "--log-max-age":
+			if i+1 < len(os.Args[1:]) {
+				maxAge, err := time.ParseDuration(os.Args[i+2])
+				if err != nil || maxAge <= 0 {
+					fmt.Printf("Invalid --log-max-age: %s (expected a positive duration like 24h)\n", os.Args[i+2])
+					os.Exit(1)
+				}
+				fileSinkOpts.MaxAge = maxAge
+			}
+		case "--log-max-files":
+			if i+1 < len(os.Args[1:]) {
+				maxFiles, err := strconv.Atoi(os.Args[i+2])
+				if err != nil || maxFiles < 0 {
+					fmt.Printf("Invalid --log-max-files: %s (expected a number of files)\n", os.Args[i+2])
+					os.Exit(1)
+				}
+				fileSinkOpts.MaxFiles = maxFiles
+			}
 		}
 	}
 
-	// Initialize Docker client
-	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	// Extra error patterns for the error rate (in addition to ERROR/FATAL lines)
+	errorPatterns, err := compileErrorPatterns(errorPatternValues)
 	if err != nil {
-		fmt.Printf("Error creating Docker client: %v\n", err)
+		fmt.Printf("Invalid --error-pattern: %v\n", err)
 		os.Exit(1)
 	}
-	defer cli.Close()
 
-	// Initialize LogBroker and RateTracker (shared between TUI and MCP server)
-	logBroker := NewLogBroker(cli)
-	rateTracker := NewRateTrackerConsumer()
-	logBroker.RegisterConsumer(rateTracker)
+	// MCP exec_command: disabled unless --mcp-exec is given, and then limited to the allowlist
+	if !mcpExec {
+		mcpExecPolicy = nil
+	} else {
+		for _, rule := range mcpExecAllow {
+			if err := mcpExecPolicy.AddRule(rule); err != nil {
+				fmt.Printf("Invalid --mcp-exec-allow: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		if len(mcpExecPolicy.Rules) == 0 {
+			fmt.Println("--mcp-exec requires at least one --mcp-exec-allow rule")
+			os.Exit(1)
+		}
+	}
+
+	// Resolve Docker endpoints (--context, --host, or the current context / environment)
+	endpoints, err := resolveEndpoints(contextNames, hostURLs)
+	if err != nil {
+		fmt.Printf("Error resolving Docker endpoints: %v\n", err)
+		os.Exit(1)
+	}
+
+	// One client, LogBroker, RateTracker and EventWatcher per endpoint
+	hosts := make([]*dockerHost, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		host, err := newDockerHost(endpoint)
+		if err != nil {
+			fmt.Printf("Error creating Docker client: %v\n", err)
+			os.Exit(1)
+		}
+		defer host.client.Close()
+		host.rateTracker.SetErrorPatterns(errorPatterns)
+		hosts = append(hosts, host)
+	}
+
+	// First endpoint: shared with the MCP server
+	cli := hosts[0].client
+	logBroker := hosts[0].logBroker
+	rateTracker := hosts[0].rateTracker
+
+	// Persist logs to disk (--log-dir): registered before the streams start so that
+	// the initial tails are written too. One subdirectory per host with several hosts.
+	if fileSinkOpts.Dir != "" {
+		for _, host := range hosts {
+			opts := fileSinkOpts
+			if len(hosts) > 1 {
+				opts.Dir = filepath.Join(fileSinkOpts.Dir, sinkFileName(host.name))
+			}
+			sink, err := NewFileSinkConsumer(opts)
+			if err != nil {
+				fmt.Printf("Error creating log sink: %v\n", err)
+				os.Exit(1)
+			}
+			defer sink.Close()
+			host.logBroker.RegisterConsumer(sink)
+		}
+	}
+
+	// Subscribe to Docker events: keeps LogBroker streams (and the TUI list) in sync
+	// without polling ContainerList. Full resync happens only on (re)connection.
+	// Every host keeps streaming in the background so that switching hosts is instant.
+	for _, host := range hosts {
+		host.Start()
+		defer host.eventWatcher.Stop()
+	}
+	stopLogStreams := func() {
+		for _, host := range hosts {
+			host.logBroker.StopAll()
+		}
+	}
 
 	// CRITICAL GOROUTINE LEAK PREVENTION: Monitor goroutine count
 	// Panic if count exceeds threshold to prevent accumulation crash
@@ -133,15 +341,15 @@ func main() {
 		}
 	})
 
-	// Create CPU stats cache for instant MCP responses
-	// NOTE: No automatic refresh - model updates the cache when it receives CPU stats
-	cpuCache := NewCPUStatsCache(cli, 5*time.Second)
+	// Stats cache (CPU, memory, I/O, PIDs) of the first host for instant MCP responses
+	// Filled by the TUI when it polls stats, or by pollStats in HTTP-only mode
+	statsCache := hosts[0].statsCache
 
 	// Start MCP server if requested
 	var mcpServer *MCPServer
 	var mcpErrChan chan error
 	if mcpServerMode {
-		mcpServer, err = NewMCPServer(cli, logBroker, rateTracker, cpuCache, mcpPort)
+		mcpServer, err = NewMCPServer(cli, logBroker, rateTracker, statsCache, mcpExecPolicy, mcpPort)
 		if err != nil {
 			fmt.Printf("Error creating MCP server: %v\n", err)
 			os.Exit(1)
@@ -162,6 +370,13 @@ func main() {
 			sigChan := make(chan os.Signal, 1)
 			signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
+			// No TUI polls stats: fill the stats cache for get_stats
+			stopStats := make(chan struct{})
+			defer close(stopStats)
+			safeGo("stats-poller", func() {
+				pollStats(cli, statsCache, 5*time.Second, stopStats)
+			})
+
 			// Run server in goroutine with crash protection
 			safeGo("mcp-server-http-only", func() {
 				if err := mcpServer.Start(); err != nil {
@@ -224,14 +439,18 @@ func main() {
 		cpuStats:         make(map[string][]float64),
 		cpuCurrent:       make(map[string]float64),
 		cpuPrevStats:     make(map[string]*container.StatsResponse),
+		statsCurrent:     make(map[string]ContainerStats),
 		demoMode:         demoMode,
 		debugMonitor:     debugMonitor,
+		doubleClickShell: doubleClickShell,
 		logsBufferLength: logsBufferLength,
 		logsColorEnabled: true, // Enable colored backgrounds in logs by default
 		logBroker:        logBroker,
 		rateTracker:      rateTracker,
-		mcpServer:        mcpServer, // May be nil if not running
-		cpuCache:         cpuCache,  // Shared CPU cache for instant MCP responses
+		mcpServer:        mcpServer,  // May be nil if not running
+		statsCache:       statsCache, // Shared stats cache for instant MCP responses
+		eventWatcher:     hosts[0].eventWatcher,
+		hosts:            hosts,
 	}
 
 	// Setup signal handling for graceful shutdown
@@ -245,9 +464,7 @@ func main() {
 	safeGo("shutdown-handler", func() {
 		<-sigChan
 		// CRITICAL FIX: Stop all log streams first
-		if logBroker != nil {
-			logBroker.StopAll()
-		}
+		stopLogStreams()
 		if mcpServer != nil {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
@@ -270,9 +487,7 @@ func main() {
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Error running program: %v\n", err)
 		// CRITICAL FIX: Stop all log streams before shutdown
-		if logBroker != nil {
-			logBroker.StopAll()
-		}
+		stopLogStreams()
 		if mcpServer != nil {
 			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 			defer cancel()
@@ -282,9 +497,7 @@ func main() {
 	}
 
 	// Clean shutdown of MCP server and log broker
-	if logBroker != nil {
-		logBroker.StopAll()
-	}
+	stopLogStreams()
 	if mcpServer != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
@@ -0,0 +1,422 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// MCPExecRule allows commands starting with Prefix in containers whose name matches Pattern
+type MCPExecRule struct {
+	Pattern string   // Glob on the container name (path.Match syntax), "*" for all containers
+	Prefix  []string // Command words; a last word ending with "/" only allows paths below it as arguments
+	AnyArgs bool     // Rule ended with "*": any arguments may follow Prefix
+}
+
+// String returns the command part of the rule as written in --mcp-exec-allow
+func (r MCPExecRule) String() string {
+	command := strings.Join(r.Prefix, " ")
+	if r.AnyArgs {
+		command += " *"
+	}
+	return command
+}
+
+// MCPExecPolicy configures the exec_command tool (nil: tool not registered)
+type MCPExecPolicy struct {
+	Rules     []MCPExecRule
+	Timeout   time.Duration // Time limit per command
+	MaxOutput int           // Bytes kept per stream (stdout, stderr)
+}
+
+// NewMCPExecPolicy returns a policy with default limits and no allowed command
+func NewMCPExecPolicy() *MCPExecPolicy {
+	return &MCPExecPolicy{Timeout: 10 * time.Second, MaxOutput: 64 << 10}
+}
+
+// AddRule parses a --mcp-exec-allow value: PATTERN=COMMAND PREFIX, e.g. "api-*=cat /etc/"
+// A last word "*" allows any arguments after the prefix ("db=pg_isready *")
+func (p *MCPExecPolicy) AddRule(value string) error {
+	pattern, prefix, ok := strings.Cut(value, "=")
+	pattern = strings.TrimSpace(pattern)
+	if !ok || pattern == "" {
+		return fmt.Errorf("%q: expected CONTAINER_PATTERN=COMMAND_PREFIX", value)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return fmt.Errorf("%q: invalid container pattern: %w", value, err)
+	}
+	words, err := splitCommandLine(prefix)
+	if err != nil {
+		return fmt.Errorf("%q: %w", value, err)
+	}
+	rule := MCPExecRule{Pattern: pattern, Prefix: words}
+	if len(words) > 0 && words[len(words)-1] == "*" {
+		rule.Prefix = words[:len(words)-1]
+		rule.AnyArgs = true
+	}
+	if len(rule.Prefix) == 0 {
+		return fmt.Errorf("%q: empty command prefix", value)
+	}
+	if rule.AnyArgs && strings.HasSuffix(rule.Prefix[len(rule.Prefix)-1], "/") {
+		return fmt.Errorf("%q: a directory prefix cannot be followed by *", value)
+	}
+	p.Rules = append(p.Rules, rule)
+	return nil
+}
+
+// Allowed reports whether the command may run in the named container
+func (p *MCPExecPolicy) Allowed(containerName string, cmd []string) bool {
+	for _, rule := range p.Rules {
+		if matched, _ := path.Match(rule.Pattern, containerName); matched && rule.allows(cmd) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowedPrefixes returns the command prefixes allowed in the named container
+func (p *MCPExecPolicy) AllowedPrefixes(containerName string) []string {
+	prefixes := []string{}
+	for _, rule := range p.Rules {
+		if matched, _ := path.Match(rule.Pattern, containerName); matched {
+			prefixes = append(prefixes, rule.String())
+		}
+	}
+	return prefixes
+}
+
+// String summarizes the rules for the tool description
+func (p *MCPExecPolicy) String() string {
+	rules := make([]string, len(p.Rules))
+	for i, rule := range p.Rules {
+		rules[i] = fmt.Sprintf("'%s' in %s", rule, rule.Pattern)
+	}
+	return strings.Join(rules, ", ")
+}
+
+// allows reports whether cmd matches the rule:
+//   - "curl -s localhost/health": exactly these words, no extra argument
+//   - "cat /etc/": "cat" then one or more paths below /etc/, without ".." segments
+//   - "pg_isready *": "pg_isready" then any arguments
+func (r MCPExecRule) allows(cmd []string) bool {
+	last := r.Prefix[len(r.Prefix)-1]
+	if !strings.HasSuffix(last, "/") {
+		if len(cmd) < len(r.Prefix) || (!r.AnyArgs && len(cmd) != len(r.Prefix)) {
+			return false
+		}
+		for i, word := range r.Prefix {
+			if cmd[i] != word {
+				return false
+			}
+		}
+		return true
+	}
+
+	// Directory rule: every argument after the fixed words is checked, so a path outside the
+	// directory cannot be smuggled in as an extra argument
+	fixed := r.Prefix[:len(r.Prefix)-1]
+	if len(cmd) <= len(fixed) {
+		return false
+	}
+	for i, word := range fixed {
+		if cmd[i] != word {
+			return false
+		}
+	}
+	for _, arg := range cmd[len(fixed):] {
+		if !pathBelow(arg, last) {
+			return false
+		}
+	}
+	return true
+}
+
+// pathBelow reports whether p is a path below dir (which ends with "/"), without ".." segments
+func pathBelow(p, dir string) bool {
+	if len(p) <= len(dir) || !strings.HasPrefix(p, dir) {
+		return false
+	}
+	for _, segment := range strings.Split(p, "/") {
+		if segment == ".." {
+			return false
+		}
+	}
+	return true
+}
+
+// splitCommandLine splits a command into words like a shell would for quoting only:
+// single and double quotes group words, backslash escapes the next character, nothing is expanded
+func splitCommandLine(s string) ([]string, error) {
+	words := []string{}
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inWord = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				word.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// cappedBuffer keeps the first max bytes written and counts the rest
+type cappedBuffer struct {
+	data  []byte
+	max   int
+	total int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	b.total += len(p)
+	if room := b.max - len(b.data); room > 0 {
+		if len(p) > room {
+			b.data = append(b.data, p[:room]...)
+		} else {
+			b.data = append(b.data, p...)
+		}
+	}
+	// Always report success: the stream is drained until the command ends
+	return len(p), nil
+}
+
+func (b *cappedBuffer) truncated() bool {
+	return b.total > len(b.data)
+}
+
+// ExecResult is the exec_command result for one command
+type ExecResult struct {
+	Container       string   `json:"container"`
+	Command         []string `json:"command"`
+	ExitCode        int      `json:"exit_code"`
+	Stdout          string   `json:"stdout"`
+	Stderr          string   `json:"stderr"`
+	StdoutTruncated bool     `json:"stdout_truncated,omitempty"`
+	StderrTruncated bool     `json:"stderr_truncated,omitempty"`
+	TimedOut        bool     `json:"timed_out,omitempty"`
+	DurationMs      int64    `json:"duration_ms"`
+
+	// Set when the command was not run (JSON format only, the text format explains it in a sentence)
+	Error           string   `json:"error,omitempty"`
+	AllowedPrefixes []string `json:"allowed_prefixes,omitempty"`
+}
+
+// runContainerExec runs a non-interactive command (no TTY, no stdin) and collects its output
+// On timeout the attach is closed and ExitCode is -1; the process may keep running in the container.
+func runContainerExec(ctx context.Context, cli *client.Client, containerID string, cmd []string, timeout time.Duration, maxOutput int) (*ExecResult, error) {
+	start := time.Now()
+	execCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	created, err := cli.ContainerExecCreate(execCtx, containerID, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exec create: %w", err)
+	}
+
+	attach, err := cli.ContainerExecAttach(execCtx, created.ID, container.ExecAttachOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("exec attach: %w", err)
+	}
+	defer attach.Close()
+
+	stdout := &cappedBuffer{max: maxOutput}
+	stderr := &cappedBuffer{max: maxOutput}
+	copyDone := make(chan error, 1)
+	go func() {
+		_, err := stdcopy.StdCopy(stdout, stderr, attach.Reader)
+		copyDone <- err
+	}()
+
+	result := &ExecResult{Command: cmd, ExitCode: -1}
+	select {
+	case err := <-copyDone:
+		if err != nil {
+			return nil, fmt.Errorf("exec output: %w", err)
+		}
+		// Output is complete: the exit code is available shortly after
+		if result.ExitCode, err = waitExecExitCode(ctx, cli, created.ID); err != nil {
+			return nil, fmt.Errorf("exec inspect: %w", err)
+		}
+	case <-execCtx.Done():
+		attach.Close()
+		<-copyDone
+		result.TimedOut = true
+	}
+
+	result.Stdout = string(stdout.data)
+	result.Stderr = string(stderr.data)
+	result.StdoutTruncated = stdout.truncated()
+	result.StderrTruncated = stderr.truncated()
+	result.DurationMs = time.Since(start).Milliseconds()
+	return result, nil
+}
+
+// waitExecExitCode returns the exit code of a finished exec instance
+func waitExecExitCode(ctx context.Context, cli *client.Client, execID string) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	for {
+		inspect, err := cli.ContainerExecInspect(ctx, execID)
+		if err != nil {
+			return -1, err
+		}
+		if !inspect.Running {
+			return inspect.ExitCode, nil
+		}
+		select {
+		case <-ctx.Done():
+			return -1, ctx.Err()
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+}
+
+// handleExecCommand implements the exec_command tool (only registered with --mcp-exec)
+func (s *MCPServer) handleExecCommand(ctx context.Context, request *protocol.CallToolRequest) (*protocol.CallToolResult, error) {
+	// Record MCP activity
+	sessionID := getSessionID(ctx)
+	s.recordActivity(sessionID)
+	log.Printf("Tool: %s", request.Name)
+
+	args := new(ExecCommandArgs)
+	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := splitCommandLine(args.Command)
+	if err != nil {
+		return nil, fmt.Errorf("invalid command: %w", err)
+	}
+	if len(cmd) == 0 {
+		return nil, fmt.Errorf("invalid command: empty")
+	}
+
+	// Exact name or ID only: a partial match could run the command in another container
+	containers, err := loadContainersSync(s.dockerClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load containers: %w", err)
+	}
+	c, candidates, found := exactContainerMatch(containers, args.Container)
+	if !found {
+		message := "no container named " + args.Container
+		if len(candidates) > 0 {
+			message += " (did you mean: " + strings.Join(candidates, ", ") + "?)"
+		}
+		if jsonFormat {
+			return jsonResult(ExecResult{Command: cmd, ExitCode: -1, Error: message})
+		}
+		return &protocol.CallToolResult{
+			Content: []protocol.Content{
+				&protocol.TextContent{
+					Type: "text",
+					Text: "✗ " + message,
+				},
+			},
+		}, nil
+	}
+
+	name := getContainerName(c)
+	denied := ExecResult{Container: name, Command: cmd, ExitCode: -1}
+	text := ""
+	switch {
+	case c.State != "running":
+		denied.Error = "container is " + c.State
+		text = fmt.Sprintf("✗ %s: container is %s, commands can only run in running containers", name, c.State)
+	case !s.execPolicy.Allowed(name, cmd):
+		log.Printf("exec_command denied in %s: %s", name, strings.Join(cmd, " "))
+		denied.Error = "command not allowed"
+		denied.AllowedPrefixes = s.execPolicy.AllowedPrefixes(name)
+		if len(denied.AllowedPrefixes) == 0 {
+			text = fmt.Sprintf("✗ %s: no command is allowed in this container", name)
+		} else {
+			text = fmt.Sprintf("✗ %s: command not allowed, allowed prefixes: %s", name, strings.Join(denied.AllowedPrefixes, ", "))
+		}
+	}
+	if jsonFormat && denied.Error != "" {
+		return jsonResult(denied)
+	}
+	if text != "" {
+		return &protocol.CallToolResult{
+			Content: []protocol.Content{
+				&protocol.TextContent{
+					Type: "text",
+					Text: text,
+				},
+			},
+		}, nil
+	}
+
+	log.Printf("exec_command in %s: %s", name, strings.Join(cmd, " "))
+	result, err := runContainerExec(ctx, s.dockerClient, c.ID, cmd, s.execPolicy.Timeout, s.execPolicy.MaxOutput)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run command in %s: %w", name, err)
+	}
+	result.Container = name
+
+	return jsonResult(result)
+}
+
+// exactContainerMatch returns the container named name (or whose ID starts with name, at least
+// a short ID). Without one, candidates lists the names partially matching like other tools.
+func exactContainerMatch(containers []types.Container, name string) (types.Container, []string, bool) {
+	nameLower := strings.ToLower(name)
+	for _, c := range containers {
+		if getContainerName(c) == name || (len(name) >= 12 && strings.HasPrefix(c.ID, nameLower)) {
+			return c, nil, true
+		}
+	}
+	var candidates []string
+	for _, c := range containers {
+		containerName := getContainerName(c)
+		if strings.Contains(strings.ToLower(containerName), nameLower) || strings.Contains(strings.ToLower(c.ID), nameLower) {
+			candidates = append(candidates, containerName)
+		}
+	}
+	return types.Container{}, candidates, false
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/docker/docker/api/types"
+)
+
+// MCP resource URIs: clients can attach them as context without a tool call
+const (
+	resourceContainersURI      = "docker://containers"
+	resourceLogsURITemplate    = "docker://containers/{name}/logs{?tail}"
+	resourceInspectURITemplate = "docker://containers/{name}/inspect"
+
+	resourceDefaultTail = 100
+	resourceMaxTail     = 10000
+)
+
+// registerResources registers the MCP resources and resource templates
+func (s *MCPServer) registerResources() error {
+	s.mcpServer.RegisterResource(&protocol.Resource{
+		URI:         resourceContainersURI,
+		Name:        "containers",
+		Description: "All Docker containers (running and stopped) with status, CPU usage, log rate, error rate and ports, as in list_containers",
+		MimeType:    "application/json",
+	}, s.handleContainersResource)
+
+	if err := s.mcpServer.RegisterResourceTemplate(&protocol.ResourceTemplate{
+		URITemplate: resourceLogsURITemplate,
+		Name:        "container-logs",
+		Description: fmt.Sprintf("Recent logs of a container (name or ID, partial match), one line per entry with its timestamp, stderr lines marked. tail: number of lines (default %d, max %d)", resourceDefaultTail, resourceMaxTail),
+		MimeType:    "text/plain",
+	}, s.handleLogsResource); err != nil {
+		return fmt.Errorf("failed to register logs resource template: %w", err)
+	}
+
+	if err := s.mcpServer.RegisterResourceTemplate(&protocol.ResourceTemplate{
+		URITemplate: resourceInspectURITemplate,
+		Name:        "container-inspect",
+		Description: "Configuration, state, health, mounts, networks and resource limits of a container (name or ID, partial match), as in inspect_container. Secret environment values are redacted",
+		MimeType:    "application/json",
+	}, s.handleInspectResource); err != nil {
+		return fmt.Errorf("failed to register inspect resource template: %w", err)
+	}
+
+	return nil
+}
+
+// handleContainersResource serves docker://containers
+func (s *MCPServer) handleContainersResource(ctx context.Context, request *protocol.ReadResourceRequest) (*protocol.ReadResourceResult, error) {
+	// Record MCP activity
+	sessionID := getSessionID(ctx)
+	s.recordActivity(sessionID)
+	log.Printf("Resource: %s", request.URI)
+
+	containers, err := loadContainersSync(s.dockerClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load containers: %w", err)
+	}
+
+	return jsonResourceResult(request.URI, ListContainersResult{Containers: s.containerSummaries(containers)})
+}
+
+// handleLogsResource serves docker://containers/{name}/logs{?tail}
+func (s *MCPServer) handleLogsResource(ctx context.Context, request *protocol.ReadResourceRequest) (*protocol.ReadResourceResult, error) {
+	// Record MCP activity
+	sessionID := getSessionID(ctx)
+	s.recordActivity(sessionID)
+	log.Printf("Resource: %s", request.URI)
+
+	tail := resourceDefaultTail
+	if value := resourceArgument(request, "tail"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid tail %q: expected a number of lines", value)
+		}
+		if n > 0 {
+			tail = min(n, resourceMaxTail)
+		}
+	}
+
+	c, err := s.resourceContainer(request)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := s.logBroker.FetchRecentEntries([]string{c.ID}, strconv.Itoa(tail))[c.ID]
+	var output strings.Builder
+	for _, entry := range entries {
+		if !entry.Timestamp.IsZero() {
+			output.WriteString(entry.Timestamp.UTC().Format(time.RFC3339Nano) + " ")
+		}
+		output.WriteString(stripAnsiCodes(formatMCPLogLine(entry, "")))
+		output.WriteString("\n")
+	}
+
+	return protocol.NewReadResourceResult([]protocol.ResourceContents{
+		&protocol.TextResourceContents{
+			URI:      request.URI,
+			MimeType: "text/plain",
+			Text:     output.String(),
+		},
+	}), nil
+}
+
+// handleInspectResource serves docker://containers/{name}/inspect
+func (s *MCPServer) handleInspectResource(ctx context.Context, request *protocol.ReadResourceRequest) (*protocol.ReadResourceResult, error) {
+	// Record MCP activity
+	sessionID := getSessionID(ctx)
+	s.recordActivity(sessionID)
+	log.Printf("Resource: %s", request.URI)
+
+	c, err := s.resourceContainer(request)
+	if err != nil {
+		return nil, err
+	}
+
+	inspectCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	data, err := s.dockerClient.ContainerInspect(inspectCtx, c.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect %s: %w", getContainerName(c), err)
+	}
+
+	return jsonResourceResult(request.URI, buildInspectInfo(&data))
+}
+
+// resourceContainer returns the container named in a resource URI: an exact name first,
+// then the first partial match like the tools
+func (s *MCPServer) resourceContainer(request *protocol.ReadResourceRequest) (types.Container, error) {
+	name := resourceArgument(request, "name")
+	if name == "" {
+		return types.Container{}, fmt.Errorf("missing container name in %s", request.URI)
+	}
+
+	containers, err := loadContainersSync(s.dockerClient)
+	if err != nil {
+		return types.Container{}, fmt.Errorf("failed to load containers: %w", err)
+	}
+	for _, c := range containers {
+		if getContainerName(c) == name {
+			return c, nil
+		}
+	}
+
+	matched, err := matchContainersByName(s.dockerClient, []string{name})
+	if err != nil {
+		return types.Container{}, fmt.Errorf("failed to match containers: %w", err)
+	}
+	if len(matched) == 0 {
+		return types.Container{}, fmt.Errorf("no container found matching %q", name)
+	}
+	return matched[0], nil
+}
+
+// resourceArgument returns a URI template variable of a resource request ("" when absent)
+func resourceArgument(request *protocol.ReadResourceRequest, name string) string {
+	switch value := request.Arguments[name].(type) {
+	case string:
+		return value
+	case []string:
+		// Template matches are lists of values, a single one for simple variables
+		if len(value) > 0 {
+			return value[0]
+		}
+	}
+	return ""
+}
+
+// jsonResourceResult returns a resource result with v as indented JSON text
+func jsonResourceResult(uri string, v any) (*protocol.ReadResourceResult, error) {
+	jsonOutput, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+
+	return protocol.NewReadResourceResult([]protocol.ResourceContents{
+		&protocol.TextResourceContents{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     string(jsonOutput),
+		},
+	}), nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/ThinkInAIXYZ/go-mcp/server"
+)
+
+// readResource reads uri through handler with the variables of uriTemplate, like the MCP server does
+func readResource(t *testing.T, handler server.ResourceHandlerFunc, uriTemplate, uri string) (string, error) {
+	t.Helper()
+	request := &protocol.ReadResourceRequest{URI: uri, Arguments: map[string]interface{}{}}
+	if uriTemplate != "" {
+		template := &protocol.ResourceTemplate{URITemplate: uriTemplate}
+		if err := template.ParseURITemplate(); err != nil {
+			t.Fatalf("ParseURITemplate(%s): %v", uriTemplate, err)
+		}
+		if !template.URITemplateParsed.Regexp().MatchString(uri) {
+			t.Fatalf("%s does not match %s", uri, uriTemplate)
+		}
+		for name, value := range template.URITemplateParsed.Match(uri) {
+			request.Arguments[name] = value.V
+		}
+	}
+
+	result, err := handler(context.Background(), request)
+	if err != nil {
+		return "", err
+	}
+	contents := result.Contents[0].(*protocol.TextResourceContents)
+	if contents.URI != uri {
+		t.Errorf("Contents URI = %s, want %s", contents.URI, uri)
+	}
+	return contents.Text, nil
+}
+
+// TestContainersResource tests that docker://containers lists every container like list_containers
+func TestContainersResource(t *testing.T) {
+	s := fakeMCPServer(t)
+	s.statsCache = NewStatsCache()
+
+	text, err := readResource(t, s.handleContainersResource, "", resourceContainersURI)
+	if err != nil {
+		t.Fatalf("Read %s: %v", resourceContainersURI, err)
+	}
+	var result ListContainersResult
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", text, err)
+	}
+	if len(result.Containers) != 1 || result.Containers[0].Name != "web" || result.Containers[0].State != "running" {
+		t.Errorf("Unexpected containers %+v", result)
+	}
+}
+
+// TestLogsResource tests the logs template, its tail variable and unknown containers
+func TestLogsResource(t *testing.T) {
+	s := fakeMCPServer(t)
+
+	text, err := readResource(t, s.handleLogsResource, resourceLogsURITemplate, "docker://containers/web/logs?tail=50")
+	if err != nil {
+		t.Fatalf("Read logs: %v", err)
+	}
+	want := "2025-03-01T10:20:30Z === Container: db ===\n2025-03-01T10:20:31Z [stderr] ERROR boom\n"
+	if text != want {
+		t.Errorf("Logs = %q, want %q", text, want)
+	}
+
+	// Partial names match like the tools
+	if text, err := readResource(t, s.handleLogsResource, resourceLogsURITemplate, "docker://containers/we/logs"); err != nil || text != want {
+		t.Errorf("Partial name logs = %q, %v", text, err)
+	}
+
+	if _, err := readResource(t, s.handleLogsResource, resourceLogsURITemplate, "docker://containers/web/logs?tail=abc"); err == nil {
+		t.Error("Expected an error for an invalid tail")
+	}
+	if _, err := readResource(t, s.handleLogsResource, resourceLogsURITemplate, "docker://containers/missing/logs"); err == nil {
+		t.Error("Expected an error for an unknown container")
+	}
+}
+
+// TestInspectResource tests the inspect template
+func TestInspectResource(t *testing.T) {
+	s := fakeMCPServer(t)
+
+	text, err := readResource(t, s.handleInspectResource, resourceInspectURITemplate, "docker://containers/web/inspect")
+	if err != nil {
+		t.Fatalf("Read inspect: %v", err)
+	}
+	var info InspectInfo
+	if err := json.Unmarshal([]byte(text), &info); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", text, err)
+	}
+	if info.ID != "0123456789abcdef" || info.Config.TTY {
+		t.Errorf("Unexpected inspect data %+v", info)
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/ThinkInAIXYZ/go-mcp/server"
+	"github.com/ThinkInAIXYZ/go-mcp/transport"
+	"github.com/docker/docker/client"
+)
+
+// jsonKeys returns the sorted keys of v marshalled as a JSON object
+func jsonKeys(t *testing.T, v any) []string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	object := map[string]any{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		t.Fatalf("Expected a JSON object, got %s", data)
+	}
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TestParseOutputFormat tests the output_format argument
+func TestParseOutputFormat(t *testing.T) {
+	for format, want := range map[string]bool{"": false, "text": false, "json": true} {
+		if got, err := parseOutputFormat(format); got != want || err != nil {
+			t.Errorf("parseOutputFormat(%q) = %v, %v, want %v", format, got, err, want)
+		}
+	}
+	if _, err := parseOutputFormat("yaml"); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+// TestMCPJSONSchemas pins the field names of the JSON results: agents parse them, so fields
+// may be added but never renamed or removed
+func TestMCPJSONSchemas(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		keys  []string
+	}{
+		{"list_containers", ListContainersResult{}, []string{"containers"}},
+		{"list_containers entry", ContainerSummary{}, []string{"cpu_percent", "errors_per_min", "id", "log_rate", "name", "ports", "state", "status"}},
+		{"get_logs", GetLogsResult{}, []string{"containers"}},
+		{"get_logs container", ContainerLogs{}, []string{"id", "lines", "name"}},
+		{"get_logs line", LogLine{Time: "t", Stream: "stderr"}, []string{"line", "stream", "time"}},
+		{"get_stats", GetStatsResult{}, []string{"containers"}},
+		{"get_stats entry", ContainerStatsInfo{}, []string{"cpu_percent", "errors_per_min_1m", "errors_per_min_5m", "id", "log_rate", "name", "ports", "state", "status"}},
+		{"inspect_container", InspectContainerResult{}, []string{"containers"}},
+		{"inspect_container entry", buildInspectInfo(sampleInspect()), []string{"config", "created", "health", "id", "mounts", "name", "networks", "resources", "state"}},
+		{"container actions", ContainerActionResult{}, []string{"results"}},
+		{"container action", ContainerActionStatus{}, []string{"action", "id", "message", "name", "status", "success"}},
+		{"follow_logs", FollowLogsResult{}, []string{"containers", "expires_at", "subscription_id"}},
+		{"unfollow_logs", UnfollowLogsResult{}, []string{"unsubscribed"}},
+		{"follow_logs notification", FollowLogsNotification{Lines: []FollowLogLine{{}}, Dropped: 1, Ended: "expired"}, []string{"dropped", "ended", "lines", "subscription_id"}},
+		{"follow_logs line", FollowLogLine{Event: "stopped", LogLine: LogLine{Time: "t", Stream: "stdout"}}, []string{"container", "event", "line", "stream", "time"}},
+		{"exec_command", ExecResult{}, []string{"command", "container", "duration_ms", "exit_code", "stderr", "stdout"}},
+	}
+	for _, tt := range tests {
+		if got := jsonKeys(t, tt.value); !reflect.DeepEqual(got, tt.keys) {
+			t.Errorf("%s keys = %v, want %v", tt.name, got, tt.keys)
+		}
+	}
+}
+
+// fakeMCPServer returns an MCP server backed by a fake Docker API with one running container
+// "web" whose logs contain a line looking like a text format header
+func fakeMCPServer(t *testing.T) *MCPServer {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/containers/json"):
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[{"Id":"0123456789abcdef","Names":["/web"],"State":"running","Status":"Up 1 hour"}]`))
+		case strings.HasSuffix(r.URL.Path, "/json"):
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"Id":"0123456789abcdef","Config":{"Tty":false}}`))
+		case strings.HasSuffix(r.URL.Path, "/logs"):
+			w.Write(muxFrame(1, "2025-03-01T10:20:30Z === Container: db ===\n"))
+			w.Write(muxFrame(2, "2025-03-01T10:20:31Z \x1b[31mERROR\x1b[0m boom\n"))
+		case strings.HasSuffix(r.URL.Path, "/restart"):
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"message":"cannot restart"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cli, err := client.NewClientWithOpts(client.WithHost("tcp://"+strings.TrimPrefix(server.URL, "http://")), client.WithVersion("1.43"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	t.Cleanup(func() { cli.Close() })
+
+	// Argument schemas are generated when the tools are created
+	for _, args := range []any{ListContainersArgs{}, GetLogsArgs{}, ContainerActionArgs{}} {
+		if _, err := protocol.NewTool(fmt.Sprintf("%T", args), "test", args); err != nil {
+			t.Fatalf("NewTool: %v", err)
+		}
+	}
+
+	return &MCPServer{
+		dockerClient:   cli,
+		logBroker:      NewLogBroker(cli),
+		activeSessions: make(map[string]time.Time),
+	}
+}
+
+// callTool calls a tool handler with JSON arguments and returns the text content
+func callTool(t *testing.T, handler func(context.Context, *protocol.CallToolRequest) (*protocol.CallToolResult, error), args string) string {
+	t.Helper()
+	result, err := handler(context.Background(), &protocol.CallToolRequest{Name: "test", RawArguments: json.RawMessage(args)})
+	if err != nil {
+		t.Fatalf("Tool call %s: %v", args, err)
+	}
+	return result.Content[0].(*protocol.TextContent).Text
+}
+
+// TestGetLogsJSONFormat tests that get_logs lines are returned as data, not parsed from text
+func TestGetLogsJSONFormat(t *testing.T) {
+	s := fakeMCPServer(t)
+
+	text := callTool(t, s.handleGetLogs, `{"output_format":"json"}`)
+	var result GetLogsResult
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", text, err)
+	}
+	want := GetLogsResult{Containers: []ContainerLogs{{
+		ID:   "0123456789ab",
+		Name: "web",
+		Lines: []LogLine{
+			{Time: "2025-03-01T10:20:30Z", Stream: streamStdout, Line: "=== Container: db ==="},
+			{Time: "2025-03-01T10:20:31Z", Stream: streamStderr, Line: "ERROR boom"},
+		},
+	}}}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("get_logs = %+v, want %+v", result, want)
+	}
+
+	// Filtering out every line leaves an empty array, not null
+	text = callTool(t, s.handleGetLogs, `{"output_format":"json","filter":"nomatch"}`)
+	if !strings.Contains(text, `"containers": []`) {
+		t.Errorf("Expected an empty containers array, got %s", text)
+	}
+
+	// Text format is unchanged
+	text = callTool(t, s.handleGetLogs, `{}`)
+	if !strings.HasPrefix(text, "=== Container: web ===\n[web] === Container: db ===\n[web] [stderr] ") {
+		t.Errorf("Unexpected text format %q", text)
+	}
+
+	if _, err := s.handleGetLogs(context.Background(), &protocol.CallToolRequest{RawArguments: json.RawMessage(`{"output_format":"xml"}`)}); err == nil {
+		t.Error("Expected an error for an invalid output_format")
+	}
+}
+
+// TestContainerActionJSONFormat tests the text and JSON results of the action tools
+func TestContainerActionJSONFormat(t *testing.T) {
+	s := fakeMCPServer(t)
+
+	if text := callTool(t, s.handleStartContainer, `{"containers":["web"]}`); text != "✓ web: already running" {
+		t.Errorf("start_container text = %q", text)
+	}
+
+	text := callTool(t, s.handleRestartContainer, `{"containers":["web"],"output_format":"json"}`)
+	var result ContainerActionResult
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", text, err)
+	}
+	if len(result.Results) != 1 {
+		t.Fatalf("Expected 1 result, got %+v", result)
+	}
+	status := result.Results[0]
+	if status.Name != "web" || status.Action != "restart" || status.Status != "failed" || status.Success || !strings.Contains(status.Message, "cannot restart") {
+		t.Errorf("Unexpected status %+v", status)
+	}
+
+	text = callTool(t, s.handleStopContainer, `{"containers":["missing"],"output_format":"json"}`)
+	if !strings.Contains(text, `"results": []`) {
+		t.Errorf("Expected an empty results array, got %s", text)
+	}
+	if text = callTool(t, s.handleStopContainer, `{"containers":["missing"]}`); text != "No containers found matching the specified names" {
+		t.Errorf("stop_container text = %q", text)
+	}
+}
+
+// TestListContainersJSONFormat tests the list_containers envelope
+func TestListContainersJSONFormat(t *testing.T) {
+	s := fakeMCPServer(t)
+	s.statsCache = NewStatsCache()
+
+	text := callTool(t, s.handleListContainers, `{"output_format":"json"}`)
+	var result ListContainersResult
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", text, err)
+	}
+	if len(result.Containers) != 1 || result.Containers[0].Name != "web" || result.Containers[0].ID != "0123456789ab" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	// Text format stays a bare array
+	if text = callTool(t, s.handleListContainers, `{}`); !strings.HasPrefix(text, "[") {
+		t.Errorf("Expected a JSON array, got %s", text)
+	}
+}
+
+// TestHealthToolCount tests that /health reports the registered tools
+func TestHealthToolCount(t *testing.T) {
+	for _, tt := range []struct {
+		execPolicy *MCPExecPolicy
+		want       int
+	}{{nil, 9}, {NewMCPExecPolicy(), 10}} {
+		s := fakeMCPServer(t)
+		s.execPolicy = tt.execPolicy
+		var err error
+		if s.mcpServer, err = server.NewServer(transport.NewStreamableHTTPServerTransport(":0")); err != nil {
+			t.Fatalf("NewServer: %v", err)
+		}
+		if err := s.registerTools(); err != nil {
+			t.Fatalf("registerTools: %v", err)
+		}
+
+		recorder := httptest.NewRecorder()
+		s.handleHealth(recorder, httptest.NewRequest(http.MethodGet, "/health", nil))
+		var health struct {
+			Tools int `json:"tools"`
+		}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &health); err != nil {
+			t.Fatalf("Invalid JSON %q: %v", recorder.Body.String(), err)
+		}
+		if health.Tools != tt.want {
+			t.Errorf("tools = %d, want %d (%v)", health.Tools, tt.want, s.toolNames)
+		}
+	}
+}
@@ -138,7 +138,11 @@ type MCPServer struct {
 	dockerClient      *client.Client
 	logBroker         *LogBroker
 	rateTracker       *RateTrackerConsumer
-	cpuCache          *CPUStatsCache       // CPU stats cache for instant responses
+	statsCache        *StatsCache          // Container stats cache for instant responses
+	execPolicy        *MCPExecPolicy       // exec_command allowlist (nil: tool disabled)
+	toolNames         []string             // Registered tool names (reported by /health)
+	streamConsumer    *MCPStreamConsumer   // follow_logs subscriptions (LogBroker consumer)
+	transport         transport.ServerTransport
 	mcpServer         *server.Server
 	httpServer        *http.Server
 	port              int
@@ -152,7 +156,7 @@ type MCPServer struct {
 }
 
 // NewMCPServer creates a new MCP server instance using go-mcp with StreamableHTTPServerTransport
-func NewMCPServer(dockerClient *client.Client, logBroker *LogBroker, rateTracker *RateTrackerConsumer, cpuCache *CPUStatsCache, port int) (*MCPServer, error) {
+func NewMCPServer(dockerClient *client.Client, logBroker *LogBroker, rateTracker *RateTrackerConsumer, statsCache *StatsCache, execPolicy *MCPExecPolicy, port int) (*MCPServer, error) {
 	// Create log buffer (keep last 50 entries)
 	logBuffer := NewMCPLogBuffer(50)
 
@@ -188,7 +192,8 @@ func NewMCPServer(dockerClient *client.Client, logBroker *LogBroker, rateTracker
 		dockerClient:   dockerClient,
 		logBroker:      logBroker,
 		rateTracker:    rateTracker,
-		cpuCache:       cpuCache,
+		statsCache:     statsCache,
+		execPolicy:     execPolicy,
 		port:           port,
 		activeSessions: make(map[string]time.Time),
 		logBuffer:      logBuffer,
@@ -209,6 +214,8 @@ func NewMCPServer(dockerClient *client.Client, logBroker *LogBroker, rateTracker
 		transport.WithStreamableHTTPServerTransportOptionLogger(customLogger),
 	)
 
+	s.transport = mcpTransport
+
 	// CRITICAL: Re-apply log redirection after transport creation
 	// The transport may have reset the logger during initialization
 	log.SetOutput(logWriter)
@@ -226,11 +233,26 @@ func NewMCPServer(dockerClient *client.Client, logBroker *LogBroker, rateTracker
 		return nil, fmt.Errorf("failed to create MCP server: %w", err)
 	}
 
+	// follow_logs: lines are pushed to the session SSE stream through the transport
+	s.streamConsumer = NewMCPStreamConsumer(func(sessionID string, message []byte) error {
+		ctx, cancel := context.WithTimeout(context.Background(), followSendTimeout)
+		defer cancel()
+		return s.transport.Send(ctx, sessionID, message)
+	})
+	if logBroker != nil {
+		logBroker.RegisterConsumer(s.streamConsumer)
+	}
+
 	// Register tools
 	if err := s.registerTools(); err != nil {
 		return nil, fmt.Errorf("failed to register tools: %w", err)
 	}
 
+	// Register resources (containers, logs and inspect data attached as context)
+	if err := s.registerResources(); err != nil {
+		return nil, fmt.Errorf("failed to register resources: %w", err)
+	}
+
 	// Setup custom HTTP server with health check endpoint
 	mux := http.NewServeMux()
 
@@ -252,35 +274,20 @@ func (s *MCPServer) Start() error {
 	// CRITICAL FIX: Create cancellable context for graceful shutdown
 	s.shutdownCtx, s.shutdownCancel = context.WithCancel(context.Background())
 
-	// Start LogBroker streaming for all running containers
+	// LogBroker streaming is driven by the EventWatcher started in main.go
 	go func() {
-		containers, err := loadContainersSync(s.dockerClient)
-		if err == nil {
-			s.logBroker.StartStreaming(containers)
-		}
-
-		// Refresh container list every 5 seconds
-		ticker := time.NewTicker(5 * time.Second)
-		defer ticker.Stop()
-
 		// Cleanup stale MCP sessions every 10 seconds
 		cleanupTicker := time.NewTicker(10 * time.Second)
 		defer cleanupTicker.Stop()
 
 		for {
 			select {
-			case <-ticker.C:
-				// CRITICAL FIX: Check context to prevent access after shutdown
-				if s.shutdownCtx.Err() != nil {
-					return
-				}
-				containers, err := loadContainersSync(s.dockerClient)
-				if err == nil {
-					s.logBroker.StartStreaming(containers)
-				}
 			case <-cleanupTicker.C:
 				// Clean up stale MCP sessions
 				s.cleanupStaleSessions()
+				if expired := s.streamConsumer.Expire(time.Now()); expired > 0 {
+					log.Printf("follow_logs: %d subscription(s) expired or without session", expired)
+				}
 			case <-s.shutdownCtx.Done():
 				// CRITICAL FIX: Exit goroutine cleanly on shutdown
 				return
@@ -300,6 +307,12 @@ func (s *MCPServer) Shutdown(ctx context.Context) error {
 		s.shutdownCancel()
 	}
 
+	// Stop follow_logs subscriptions
+	if s.logBroker != nil {
+		s.logBroker.UnregisterConsumer(s.streamConsumer)
+	}
+	s.streamConsumer.Close()
+
 	// CRITICAL FIX: Close log file to prevent file descriptor leak
 	if s.logFile != nil {
 		s.logFile.Close()
@@ -392,7 +405,7 @@ func (s *MCPServer) handleHealth(w http.ResponseWriter, r *http.Request) {
 		"container_count": containerCount,
 		"goroutines":      getGoroutineCount(),   // Monitor for goroutine leaks
 		"file_descriptors": countOpenFDs(),       // Monitor for FD leaks
-		"tools":           6,                     // get_logs, list_containers, get_stats, start_container, stop_container, restart_container
+		"tools":           len(s.toolNames),      // Registered tools (exec_command only with --mcp-exec)
 		"protocol":        "MCP",
 		"transport":       "StreamableHTTPServerTransport (stateful, SSE)",
 	}
@@ -401,18 +414,24 @@ func (s *MCPServer) handleHealth(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// registerTool registers an MCP tool and records its name for /health
+func (s *MCPServer) registerTool(tool *protocol.Tool, handler server.ToolHandlerFunc) {
+	s.mcpServer.RegisterTool(tool, handler)
+	s.toolNames = append(s.toolNames, tool.Name)
+}
+
 // registerTools registers all MCP tools
 func (s *MCPServer) registerTools() error {
 	// Register list_containers tool
 	listContainersTool, err := protocol.NewTool(
 		"list_containers",
-		"List all Docker containers with their status, CPU usage, log rate, uptime, and exposed ports. Filter by state (running/stopped) or name. Use this to get an overview of all containers or find specific ones.",
+		"List all Docker containers with their status, CPU usage, log rate, error rate (errors per minute), uptime, and exposed ports. Filter by state (running/stopped) or name. Use this to get an overview of all containers or find specific ones.",
 		ListContainersArgs{},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create list_containers tool: %w", err)
 	}
-	s.mcpServer.RegisterTool(listContainersTool, s.handleListContainers)
+	s.registerTool(listContainersTool, s.handleListContainers)
 
 	// Register get_logs tool
 	getLogsTool, err := protocol.NewTool(
@@ -423,18 +442,64 @@ func (s *MCPServer) registerTools() error {
 	if err != nil {
 		return fmt.Errorf("failed to create get_logs tool: %w", err)
 	}
-	s.mcpServer.RegisterTool(getLogsTool, s.handleGetLogs)
+	s.registerTool(getLogsTool, s.handleGetLogs)
 
 	// Register get_stats tool
 	getStatsTool, err := protocol.NewTool(
 		"get_stats",
-		"Get detailed real-time resource statistics for specific containers including CPU percentage, optional CPU history (10 values), log rate (lines/second), current status, and ports. Useful for monitoring container performance.",
+		"Get detailed real-time resource statistics for specific containers including CPU percentage, memory usage/limit (page cache excluded), network and block I/O rates (bytes/second), PID count, optional CPU and memory history (10 values), log rate (lines/second), error rate over 1m/5m windows (errors per minute, optional 5-minute history), current status, and ports. Useful for monitoring container performance, spotting memory leaks and containers producing errors.",
 		GetStatsArgs{},
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create get_stats tool: %w", err)
 	}
-	s.mcpServer.RegisterTool(getStatsTool, s.handleGetStats)
+	s.registerTool(getStatsTool, s.handleGetStats)
+
+	// Register follow_logs tool
+	followLogsTool, err := protocol.NewTool(
+		"follow_logs",
+		"Subscribe to live Docker container logs instead of polling get_logs, e.g. while watching a deploy. Matching lines (keyword or regex filter, stdout/stderr) and container start/stop events are pushed as notifications/message (logger 'follow_logs', data with subscription_id and lines) on the MCP session stream, batched every 250ms. The subscription ends after duration_seconds (default 600), with unfollow_logs, or when the session closes.",
+		FollowLogsArgs{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create follow_logs tool: %w", err)
+	}
+	s.registerTool(followLogsTool, s.handleFollowLogs)
+
+	// Register unfollow_logs tool
+	unfollowLogsTool, err := protocol.NewTool(
+		"unfollow_logs",
+		"Stop a follow_logs subscription, or all follow_logs subscriptions of this session when subscription_id is empty.",
+		UnfollowLogsArgs{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create unfollow_logs tool: %w", err)
+	}
+	s.registerTool(unfollowLogsTool, s.handleUnfollowLogs)
+
+	// Register inspect_container tool
+	inspectContainerTool, err := protocol.NewTool(
+		"inspect_container",
+		"Inspect Docker containers (running or stopped): state with exit code, exit reason, OOMKilled flag, error and restart count, image, command, environment (secret-looking values redacted), labels, restart policy, mounts, networks, ports, health check with recent probes and resource limits. Use this to find out why a container exited or keeps restarting.",
+		InspectContainerArgs{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create inspect_container tool: %w", err)
+	}
+	s.registerTool(inspectContainerTool, s.handleInspectContainer)
+
+	// Register exec_command tool (opt-in: --mcp-exec with --mcp-exec-allow rules)
+	if s.execPolicy != nil {
+		execCommandTool, err := protocol.NewTool(
+			"exec_command",
+			fmt.Sprintf("Run a non-interactive command inside a running Docker container (no shell, no TTY) and get stdout, stderr and the exit code. Only allowlisted commands are accepted (a prefix ending with / allows paths below it as arguments, * allows any arguments, otherwise no extra argument): %s. Time limit %s, output capped at %d KB per stream. Use this to read config files or query health endpoints from inside a container.", s.execPolicy, s.execPolicy.Timeout, s.execPolicy.MaxOutput>>10),
+			ExecCommandArgs{},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create exec_command tool: %w", err)
+		}
+		s.registerTool(execCommandTool, s.handleExecCommand)
+	}
 
 	// Register start_container tool
 	startContainerTool, err := protocol.NewTool(
@@ -445,7 +510,7 @@ func (s *MCPServer) registerTools() error {
 	if err != nil {
 		return fmt.Errorf("failed to create start_container tool: %w", err)
 	}
-	s.mcpServer.RegisterTool(startContainerTool, s.handleStartContainer)
+	s.registerTool(startContainerTool, s.handleStartContainer)
 
 	// Register stop_container tool
 	stopContainerTool, err := protocol.NewTool(
@@ -456,7 +521,7 @@ func (s *MCPServer) registerTools() error {
 	if err != nil {
 		return fmt.Errorf("failed to create stop_container tool: %w", err)
 	}
-	s.mcpServer.RegisterTool(stopContainerTool, s.handleStopContainer)
+	s.registerTool(stopContainerTool, s.handleStopContainer)
 
 	// Register restart_container tool
 	restartContainerTool, err := protocol.NewTool(
@@ -467,7 +532,7 @@ func (s *MCPServer) registerTools() error {
 	if err != nil {
 		return fmt.Errorf("failed to create restart_container tool: %w", err)
 	}
-	s.mcpServer.RegisterTool(restartContainerTool, s.handleRestartContainer)
+	s.registerTool(restartContainerTool, s.handleRestartContainer)
 
 	return nil
 }
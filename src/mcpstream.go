@@ -0,0 +1,443 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/ThinkInAIXYZ/go-mcp/server"
+	"github.com/ThinkInAIXYZ/go-mcp/server/session"
+)
+
+// follow_logs limits
+const (
+	followDefaultDuration = 10 * time.Minute
+	followMaxDuration     = time.Hour
+	followQueueSize       = 1000                   // Lines waiting to be sent per subscription (more are dropped)
+	followBatchSize       = 100                    // Lines per notification
+	followFlushInterval   = 250 * time.Millisecond // Notification period while lines arrive
+	followSendTimeout     = time.Second            // Wait for room in the session queue (client not reading)
+	followKeepalive       = 30 * time.Second       // Empty notification when no line was sent, to notice closed sessions
+	followSessionTimeout  = 2 * time.Minute        // Nothing delivered for this long: session closed, expired or without SSE stream
+)
+
+// FollowLogLine is a line (or container start/stop event) pushed to a follow_logs subscriber
+type FollowLogLine struct {
+	Container string `json:"container"`
+	Event     string `json:"event,omitempty"` // started, stopped (no line)
+	LogLine
+}
+
+// FollowLogsNotification is the data of the notifications/message sent for a subscription
+type FollowLogsNotification struct {
+	SubscriptionID string          `json:"subscription_id"`
+	Lines          []FollowLogLine `json:"lines,omitempty"`   // Empty in keepalive notifications
+	Dropped        int64           `json:"dropped,omitempty"` // Lines lost since the previous notification
+	Ended          string          `json:"ended,omitempty"`   // expired, unsubscribed, session_closed
+}
+
+// logSubscription is one follow_logs call
+type logSubscription struct {
+	id         string
+	sessionID  string
+	containers map[string]bool // Container IDs; empty follows all containers, also ones started later
+	filter     string          // Lowercase substring (when regex is nil)
+	regex      *regexp.Regexp
+	stream     string
+	expires    time.Time
+
+	queue     chan FollowLogLine
+	dropped   atomic.Int64
+	delivered atomic.Int64 // Unix nanoseconds of the last notification accepted by the session
+	done      chan struct{}
+	closeOnce sync.Once
+	endReason string // Set before done is closed; empty when the session is gone (nothing is sent)
+}
+
+// matches reports whether a line is pushed to the subscription
+func (sub *logSubscription) matches(containerID, line, stream string) bool {
+	if len(sub.containers) > 0 && !sub.containers[containerID] {
+		return false
+	}
+	if sub.stream != "" && stream != sub.stream {
+		return false
+	}
+	if sub.regex != nil {
+		return sub.regex.MatchString(line)
+	}
+	return sub.filter == "" || strings.Contains(strings.ToLower(line), sub.filter)
+}
+
+// end stops the subscription goroutine
+func (sub *logSubscription) end(reason string) {
+	sub.closeOnce.Do(func() {
+		sub.endReason = reason
+		close(sub.done)
+	})
+}
+
+// MCPStreamConsumer pushes log lines matching follow_logs subscriptions to MCP sessions as
+// notifications/message on the session SSE stream (GET /mcp)
+// Lines are queued per subscription so a slow client never blocks the LogBroker.
+type MCPStreamConsumer struct {
+	send      func(sessionID string, message []byte) error
+	keepalive time.Duration
+
+	mu            sync.RWMutex
+	subscriptions map[string]*logSubscription
+	names         map[string]string // Container ID -> name, for start/stop events
+	nextID        int
+}
+
+// NewMCPStreamConsumer creates a consumer sending messages with send (transport Send in the server)
+func NewMCPStreamConsumer(send func(sessionID string, message []byte) error) *MCPStreamConsumer {
+	return &MCPStreamConsumer{
+		send:          send,
+		keepalive:     followKeepalive,
+		subscriptions: make(map[string]*logSubscription),
+		names:         make(map[string]string),
+	}
+}
+
+// Subscribe adds a subscription and starts its sender
+func (c *MCPStreamConsumer) Subscribe(sessionID string, containers map[string]string, filter string, isRegex bool, stream string, duration time.Duration) (string, error) {
+	sub := &logSubscription{
+		sessionID:  sessionID,
+		containers: make(map[string]bool),
+		stream:     stream,
+		expires:    time.Now().Add(duration),
+		queue:      make(chan FollowLogLine, followQueueSize),
+		done:       make(chan struct{}),
+	}
+	sub.delivered.Store(time.Now().UnixNano())
+	if isRegex && filter != "" {
+		regex, err := regexp.Compile("(?i)" + filter)
+		if err != nil {
+			return "", fmt.Errorf("invalid regex pattern: %w", err)
+		}
+		sub.regex = regex
+	} else {
+		sub.filter = strings.ToLower(filter)
+	}
+
+	c.mu.Lock()
+	for id, name := range containers {
+		sub.containers[id] = true
+		c.names[id] = name
+	}
+	c.nextID++
+	sub.id = fmt.Sprintf("follow-%d", c.nextID)
+	c.subscriptions[sub.id] = sub
+	c.mu.Unlock()
+
+	go c.run(sub)
+	return sub.id, nil
+}
+
+// Unsubscribe ends a subscription of the session, or all of them when id is empty
+// Returns the number of subscriptions ended.
+func (c *MCPStreamConsumer) Unsubscribe(sessionID, id string) int {
+	return c.remove(func(sub *logSubscription) bool {
+		return sub.sessionID == sessionID && (id == "" || sub.id == id)
+	}, "unsubscribed")
+}
+
+// Expire ends the subscriptions past their duration, and the ones whose session did not accept
+// a notification (lines or keepalive) for followSessionTimeout: the session was closed by the
+// client, expired in the MCP server or never opened its SSE stream
+// Called by the MCP server cleanup loop.
+func (c *MCPStreamConsumer) Expire(now time.Time) int {
+	expired := c.remove(func(sub *logSubscription) bool {
+		return now.After(sub.expires)
+	}, "expired")
+	closed := c.remove(func(sub *logSubscription) bool {
+		return now.Sub(time.Unix(0, sub.delivered.Load())) > followSessionTimeout
+	}, "session_closed")
+	return expired + closed
+}
+
+// Close ends all subscriptions (server shutdown)
+func (c *MCPStreamConsumer) Close() {
+	c.remove(func(*logSubscription) bool { return true }, "")
+}
+
+// Count returns the number of active subscriptions
+func (c *MCPStreamConsumer) Count() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.subscriptions)
+}
+
+func (c *MCPStreamConsumer) remove(match func(*logSubscription) bool, reason string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	count := 0
+	for id, sub := range c.subscriptions {
+		if match(sub) {
+			delete(c.subscriptions, id)
+			sub.end(reason)
+			count++
+		}
+	}
+	return count
+}
+
+// OnLogLine queues the line for the matching subscriptions
+func (c *MCPStreamConsumer) OnLogLine(containerID, containerName, line, stream string, timestamp time.Time) {
+	c.mu.RLock()
+	if len(c.subscriptions) == 0 {
+		c.mu.RUnlock()
+		return
+	}
+	knownName := c.names[containerID] == containerName
+	content := stripAnsiCodes(line)
+	for _, sub := range c.subscriptions {
+		if !sub.matches(containerID, content, stream) {
+			continue
+		}
+		entry := FollowLogLine{
+			Container: containerName,
+			LogLine:   mcpLogLine(LogEntry{Line: content, Stream: stream, Timestamp: timestamp}),
+		}
+		select {
+		case sub.queue <- entry:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+	c.mu.RUnlock()
+
+	if !knownName {
+		c.mu.Lock()
+		c.names[containerID] = containerName
+		c.mu.Unlock()
+	}
+}
+
+// OnContainerStatusChange queues a started/stopped event for the subscriptions following the container
+func (c *MCPStreamConsumer) OnContainerStatusChange(containerID string, isRunning bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	event := FollowLogLine{Container: c.names[containerID], Event: "stopped"}
+	if isRunning {
+		event.Event = "started"
+	}
+	if event.Container == "" {
+		event.Container = shortID(containerID)
+	}
+	event.Time = time.Now().UTC().Format(time.RFC3339Nano)
+	for _, sub := range c.subscriptions {
+		if len(sub.containers) > 0 && !sub.containers[containerID] {
+			continue
+		}
+		select {
+		case sub.queue <- event:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// run batches the queued lines of a subscription into notifications until it ends
+func (c *MCPStreamConsumer) run(sub *logSubscription) {
+	ticker := time.NewTicker(followFlushInterval)
+	defer ticker.Stop()
+
+	batch := []FollowLogLine{}
+	lastSent := time.Now()
+	for {
+		select {
+		case line := <-sub.queue:
+			batch = append(batch, line)
+			if len(batch) < followBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			// Nothing to send: keepalive now and then, so a closed session is noticed
+			// even when no line matches
+			if len(batch) == 0 && sub.dropped.Load() == 0 && time.Since(lastSent) < c.keepalive {
+				continue
+			}
+		case <-sub.done:
+			if sub.endReason != "" {
+				for len(sub.queue) > 0 && len(batch) < followBatchSize {
+					batch = append(batch, <-sub.queue)
+				}
+				c.notify(sub, FollowLogsNotification{SubscriptionID: sub.id, Lines: batch, Dropped: sub.dropped.Swap(0), Ended: sub.endReason})
+			}
+			return
+		}
+
+		if !c.notify(sub, FollowLogsNotification{SubscriptionID: sub.id, Lines: batch, Dropped: sub.dropped.Swap(0)}) {
+			// The session is gone (closed by the client or expired): drop the subscription
+			log.Printf("follow_logs %s: session closed, unsubscribed", sub.id)
+			c.remove(func(s *logSubscription) bool { return s == sub }, "")
+			return
+		}
+		batch = []FollowLogLine{}
+		lastSent = time.Now()
+	}
+}
+
+// notify sends a notification and returns false when the session no longer exists
+// Lines are counted as dropped when the client has no SSE stream open or does not read it.
+func (c *MCPStreamConsumer) notify(sub *logSubscription, data FollowLogsNotification) bool {
+	message, err := json.Marshal(protocol.NewJSONRPCNotification(protocol.NotificationLogMessage, map[string]any{
+		"level":  "info",
+		"logger": "follow_logs",
+		"data":   data,
+	}))
+	if err != nil {
+		return true
+	}
+
+	err = c.send(sub.sessionID, message)
+	switch {
+	case err == nil:
+		sub.delivered.Store(time.Now().UnixNano())
+		return true
+	case errors.Is(err, session.ErrQueueNotOpened), errors.Is(err, context.DeadlineExceeded):
+		sub.dropped.Add(int64(len(data.Lines)))
+		return true
+	}
+	return false
+}
+
+// shortID returns the 12-character container ID
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+// handleFollowLogs implements the follow_logs tool
+func (s *MCPServer) handleFollowLogs(ctx context.Context, request *protocol.CallToolRequest) (*protocol.CallToolResult, error) {
+	// Record MCP activity
+	sessionID := getSessionID(ctx)
+	s.recordActivity(sessionID)
+	log.Printf("Tool: %s", request.Name)
+
+	args := new(FollowLogsArgs)
+	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
+	if args.Stream != "" && args.Stream != streamStdout && args.Stream != streamStderr {
+		return nil, fmt.Errorf("invalid stream %q: expected 'stdout', 'stderr' or empty", args.Stream)
+	}
+
+	// Notifications go to the MCP session, not to the (short) tool call
+	mcpSessionID, err := server.GetSessionIDFromCtx(ctx)
+	if err != nil || mcpSessionID == "" {
+		return nil, fmt.Errorf("follow_logs requires an MCP session (Mcp-Session-Id header)")
+	}
+
+	duration := followDefaultDuration
+	if args.DurationSeconds > 0 {
+		duration = time.Duration(args.DurationSeconds) * time.Second
+	}
+	if duration > followMaxDuration {
+		duration = followMaxDuration
+	}
+
+	// Specific containers are resolved now; without containers every container is followed
+	containers := map[string]string{}
+	if len(args.Containers) > 0 {
+		matched, err := matchContainersByName(s.dockerClient, args.Containers)
+		if err != nil {
+			return nil, fmt.Errorf("failed to match containers: %w", err)
+		}
+		if len(matched) == 0 {
+			return &protocol.CallToolResult{
+				Content: []protocol.Content{
+					&protocol.TextContent{
+						Type: "text",
+						Text: "No containers found matching the specified names",
+					},
+				},
+			}, nil
+		}
+		for _, c := range matched {
+			containers[c.ID] = getContainerName(c)
+		}
+	}
+
+	id, err := s.streamConsumer.Subscribe(mcpSessionID, containers, args.Filter, args.IsRegex, args.Stream, duration)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("follow_logs %s: %d containers, filter %q", id, len(containers), args.Filter)
+
+	result := FollowLogsResult{
+		SubscriptionID: id,
+		Containers:     []string{},
+		ExpiresAt:      time.Now().Add(duration).UTC().Format(time.RFC3339),
+	}
+	for _, name := range containers {
+		result.Containers = append(result.Containers, name)
+	}
+	if jsonFormat {
+		return jsonResult(result)
+	}
+
+	scope := "all containers"
+	if len(result.Containers) > 0 {
+		scope = strings.Join(result.Containers, ", ")
+	}
+	return &protocol.CallToolResult{
+		Content: []protocol.Content{
+			&protocol.TextContent{
+				Type: "text",
+				Text: fmt.Sprintf("Following logs of %s as %s until %s. Matching lines arrive as notifications/message (logger \"follow_logs\") on the session stream; call unfollow_logs to stop.", scope, id, result.ExpiresAt),
+			},
+		},
+	}, nil
+}
+
+// handleUnfollowLogs implements the unfollow_logs tool
+func (s *MCPServer) handleUnfollowLogs(ctx context.Context, request *protocol.CallToolRequest) (*protocol.CallToolResult, error) {
+	// Record MCP activity
+	sessionID := getSessionID(ctx)
+	s.recordActivity(sessionID)
+	log.Printf("Tool: %s", request.Name)
+
+	args := new(UnfollowLogsArgs)
+	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %w", err)
+	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	mcpSessionID, err := server.GetSessionIDFromCtx(ctx)
+	if err != nil || mcpSessionID == "" {
+		return nil, fmt.Errorf("unfollow_logs requires an MCP session (Mcp-Session-Id header)")
+	}
+
+	count := s.streamConsumer.Unsubscribe(mcpSessionID, args.SubscriptionID)
+	if jsonFormat {
+		return jsonResult(UnfollowLogsResult{Unsubscribed: count})
+	}
+	return &protocol.CallToolResult{
+		Content: []protocol.Content{
+			&protocol.TextContent{
+				Type: "text",
+				Text: fmt.Sprintf("Stopped %d log subscription(s)", count),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThinkInAIXYZ/go-mcp/pkg"
+	"github.com/ThinkInAIXYZ/go-mcp/protocol"
+	"github.com/ThinkInAIXYZ/go-mcp/server/session"
+)
+
+// fakeSessionSender records the notifications sent per session; err is returned by send
+type fakeSessionSender struct {
+	mu       sync.Mutex
+	messages map[string][]FollowLogsNotification
+	err      error
+}
+
+func (f *fakeSessionSender) send(sessionID string, message []byte) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	var notification struct {
+		Method string `json:"method"`
+		Params struct {
+			Logger string                 `json:"logger"`
+			Data   FollowLogsNotification `json:"data"`
+		} `json:"params"`
+	}
+	json.Unmarshal(message, &notification)
+	if notification.Method != "notifications/message" || notification.Params.Logger != "follow_logs" {
+		return errors.New("unexpected notification " + string(message))
+	}
+	if f.messages == nil {
+		f.messages = map[string][]FollowLogsNotification{}
+	}
+	f.messages[sessionID] = append(f.messages[sessionID], notification.Params.Data)
+	return nil
+}
+
+// setErr makes the following sends fail with err
+func (f *fakeSessionSender) setErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
+
+// lines returns the lines received by a session
+func (f *fakeSessionSender) lines(sessionID string) []FollowLogLine {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	lines := []FollowLogLine{}
+	for _, n := range f.messages[sessionID] {
+		lines = append(lines, n.Lines...)
+	}
+	return lines
+}
+
+// waitFor polls cond until it holds or a second has passed
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// TestMCPStreamConsumerFollow tests that matching lines reach the subscribed session
+func TestMCPStreamConsumerFollow(t *testing.T) {
+	sender := &fakeSessionSender{}
+	c := NewMCPStreamConsumer(sender.send)
+	defer c.Close()
+
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)
+	c.OnLogLine("c1", "api", "ignored before subscribing", streamStdout, ts)
+
+	apiErrors, err := c.Subscribe("s1", map[string]string{"c1": "api"}, "error", false, "", time.Minute)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+	if _, err := c.Subscribe("s2", nil, "[", true, "", time.Minute); err == nil {
+		t.Error("Expected an error for an invalid regex")
+	}
+	c.Subscribe("s2", nil, "", false, streamStderr, time.Minute)
+
+	c.OnLogLine("c1", "api", "\x1b[31mERROR\x1b[0m upstream timeout", streamStdout, ts)
+	c.OnLogLine("c1", "api", "GET /health 200", streamStdout, ts)
+	c.OnLogLine("c2", "db", "error: disk full", streamStderr, ts)
+	c.OnContainerStatusChange("c1", false)
+
+	waitFor(t, "api error line and stop event", func() bool { return len(sender.lines("s1")) == 2 })
+	lines := sender.lines("s1")
+	want := FollowLogLine{Container: "api", LogLine: LogLine{Time: "2025-03-01T10:20:30Z", Stream: streamStdout, Line: "ERROR upstream timeout"}}
+	if lines[0] != want {
+		t.Errorf("Line = %+v, want %+v", lines[0], want)
+	}
+	if lines[1].Container != "api" || lines[1].Event != "stopped" {
+		t.Errorf("Expected a stopped event, got %+v", lines[1])
+	}
+
+	// s2 follows stderr of every container, including ones it was not told about
+	waitFor(t, "stderr line", func() bool { return len(sender.lines("s2")) == 2 })
+	if line := sender.lines("s2")[0]; line.Container != "db" || line.Line != "error: disk full" {
+		t.Errorf("Unexpected stderr line %+v", line)
+	}
+
+	// Another session cannot end the subscription
+	if n := c.Unsubscribe("s2", apiErrors); n != 0 {
+		t.Errorf("Expected no subscription ended for another session, got %d", n)
+	}
+	if n := c.Unsubscribe("s1", apiErrors); n != 1 || c.Count() != 1 {
+		t.Errorf("Expected 1 subscription ended and 1 left, got %d and %d", n, c.Count())
+	}
+	waitFor(t, "end notification", func() bool {
+		sender.mu.Lock()
+		defer sender.mu.Unlock()
+		messages := sender.messages["s1"]
+		return messages[len(messages)-1].Ended == "unsubscribed"
+	})
+}
+
+// TestMCPStreamConsumerExpiry tests the subscription lifetime and the cleanup of closed sessions
+func TestMCPStreamConsumerExpiry(t *testing.T) {
+	sender := &fakeSessionSender{}
+	c := NewMCPStreamConsumer(sender.send)
+	defer c.Close()
+
+	c.Subscribe("s1", nil, "", false, "", time.Minute)
+	if n := c.Expire(time.Now()); n != 0 {
+		t.Errorf("Expected no expired subscription, got %d", n)
+	}
+	if n := c.Expire(time.Now().Add(2 * time.Minute)); n != 1 || c.Count() != 0 {
+		t.Errorf("Expected the subscription to expire, got %d (%d left)", n, c.Count())
+	}
+
+	// No SSE stream open yet: lines are dropped but the subscription stays
+	sender.setErr(session.ErrQueueNotOpened)
+	c.Subscribe("s2", nil, "", false, "", time.Minute)
+	c.OnLogLine("c1", "api", "lost", streamStdout, time.Now())
+	time.Sleep(3 * followFlushInterval)
+	if c.Count() != 1 {
+		t.Fatal("Expected the subscription to survive a missing SSE stream")
+	}
+
+	// Session closed or expired in the MCP server: the subscription is removed
+	sender.setErr(pkg.ErrLackSession)
+	c.OnLogLine("c1", "api", "nobody listens", streamStdout, time.Now())
+	waitFor(t, "subscription removal", func() bool { return c.Count() == 0 })
+}
+
+// TestFollowLogsRequiresSession tests that follow_logs refuses calls without an MCP session
+func TestFollowLogsRequiresSession(t *testing.T) {
+	s := fakeMCPServer(t)
+	s.streamConsumer = NewMCPStreamConsumer((&fakeSessionSender{}).send)
+	protocol.NewTool("follow_logs", "test", FollowLogsArgs{})
+
+	_, err := s.handleFollowLogs(context.Background(), &protocol.CallToolRequest{RawArguments: json.RawMessage(`{}`)})
+	if err == nil {
+		t.Error("Expected an error without a session")
+	}
+	if s.streamConsumer.Count() != 0 {
+		t.Error("Expected no subscription")
+	}
+}
+
+// TestMCPStreamConsumerSilentSession tests that a subscription matching no line still ends
+// when its session is gone
+func TestMCPStreamConsumerSilentSession(t *testing.T) {
+	sender := &fakeSessionSender{}
+	c := NewMCPStreamConsumer(sender.send)
+	c.keepalive = 10 * time.Millisecond
+	defer c.Close()
+
+	// Live session: keepalives are delivered and keep the subscription
+	c.Subscribe("s1", nil, "never matches", false, "", time.Hour)
+	waitFor(t, "keepalive", func() bool {
+		sender.mu.Lock()
+		defer sender.mu.Unlock()
+		return len(sender.messages["s1"]) > 0
+	})
+	if n := c.Expire(time.Now().Add(followSessionTimeout / 2)); n != 0 {
+		t.Errorf("Expected the live subscription to stay, %d ended", n)
+	}
+
+	// Session closed in the MCP server: the next keepalive removes the subscription
+	sender.setErr(pkg.ErrLackSession)
+	waitFor(t, "silent subscription removal", func() bool { return c.Count() == 0 })
+
+	// No SSE stream ever opened: the subscription ends after followSessionTimeout
+	sender.setErr(session.ErrQueueNotOpened)
+	c.Subscribe("s2", nil, "never matches", false, "", time.Hour)
+	if n := c.Expire(time.Now().Add(followSessionTimeout / 2)); n != 0 {
+		t.Errorf("Expected the subscription to wait for the SSE stream, %d ended", n)
+	}
+	if n := c.Expire(time.Now().Add(followSessionTimeout + time.Second)); n != 1 || c.Count() != 0 {
+		t.Errorf("Expected the subscription without stream to end, got %d (%d left)", n, c.Count())
+	}
+}
@@ -35,6 +35,10 @@ func (s *MCPServer) handleListContainers(ctx context.Context, request *protocol.
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
 	log.Printf("[TRACE] Arguments parsed in %dms", time.Since(startTime).Milliseconds())
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	// Load containers
 	log.Printf("[TRACE] Loading containers...")
@@ -45,12 +49,6 @@ func (s *MCPServer) handleListContainers(ctx context.Context, request *protocol.
 	}
 	log.Printf("[TRACE] Loaded %d containers in %dms", len(containers), time.Since(t1).Milliseconds())
 
-	// Get CPU stats from cache (instant, no Docker API call)
-	log.Printf("[TRACE] Getting CPU stats from cache...")
-	t2 := time.Now()
-	cpuStats := s.cpuCache.Get()
-	log.Printf("[TRACE] Got CPU stats from cache in %dms", time.Since(t2).Milliseconds())
-
 	// Filter containers
 	var filtered []types.Container
 	for _, c := range containers {
@@ -76,27 +74,46 @@ func (s *MCPServer) handleListContainers(ctx context.Context, request *protocol.
 	}
 
 	// Build result list
-	type ContainerInfo struct {
-		ID         string `json:"id"`
-		Name       string `json:"name"`
-		State      string `json:"state"`
-		Status     string `json:"status"`
-		CPUPercent string `json:"cpu_percent"`
-		LogRate    string `json:"log_rate"`
-		Ports      string `json:"ports"`
-	}
-
-	var result []ContainerInfo
-	for _, c := range filtered {
+	result := s.containerSummaries(filtered)
+
+	// Convert to JSON (text format: the bare array)
+	log.Printf("[TRACE] Marshalling JSON...")
+	t3 := time.Now()
+	var toolResult *protocol.CallToolResult
+	if jsonFormat {
+		toolResult, err = jsonResult(ListContainersResult{Containers: result})
+	} else {
+		toolResult, err = jsonResult(result)
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[TRACE] Marshalled JSON in %dms", time.Since(t3).Milliseconds())
+
+	log.Printf("[TRACE] handleListContainers COMPLETE in %dms", time.Since(startTime).Milliseconds())
+
+	return toolResult, nil
+}
+
+// containerSummaries returns the list_containers entries of containers, with CPU usage and log rates
+func (s *MCPServer) containerSummaries(containers []types.Container) []ContainerSummary {
+	// Get CPU stats from cache (instant, no Docker API call)
+	log.Printf("[TRACE] Getting CPU stats from cache...")
+	t2 := time.Now()
+	cachedStats := s.statsCache.Get()
+	log.Printf("[TRACE] Got CPU stats from cache in %dms", time.Since(t2).Milliseconds())
+
+	result := []ContainerSummary{}
+	for _, c := range containers {
 		name := getContainerName(c)
 		if name == "" {
 			name = c.ID[:12] // Fallback to short ID
 		}
 
-		// Get CPU percentage (cpuStats now returns current values directly, not history)
+		// Get CPU percentage (cache returns current values directly, not history)
 		cpuPct := "0.0"
-		if cpu, ok := cpuStats[c.ID]; ok {
-			cpuPct = fmt.Sprintf("%.1f", cpu)
+		if stats, ok := cachedStats[c.ID]; ok {
+			cpuPct = fmt.Sprintf("%.1f", stats.CPUPercent)
 		}
 
 		// Get log rate
@@ -114,39 +131,27 @@ func (s *MCPServer) handleListContainers(ctx context.Context, request *protocol.
 			}
 		}
 
+		// Get error rate (last minute)
+		errorRate := "0"
+		if s.rateTracker != nil {
+			errorRate = fmt.Sprintf("%.0f", s.rateTracker.GetErrorRate(c.ID))
+		}
+
 		// Format ports
 		ports := formatPortsForMCP(c.Ports)
 
-		result = append(result, ContainerInfo{
+		result = append(result, ContainerSummary{
 			ID:         c.ID[:12],
 			Name:       name,
 			State:      c.State,
 			Status:     c.Status,
 			CPUPercent: cpuPct,
 			LogRate:    logRate,
+			ErrorRate:  errorRate,
 			Ports:      ports,
 		})
 	}
-
-	// Convert to JSON
-	log.Printf("[TRACE] Marshalling JSON...")
-	t3 := time.Now()
-	jsonOutput, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal result: %w", err)
-	}
-	log.Printf("[TRACE] Marshalled JSON in %dms", time.Since(t3).Milliseconds())
-
-	log.Printf("[TRACE] handleListContainers COMPLETE in %dms", time.Since(startTime).Milliseconds())
-
-	return &protocol.CallToolResult{
-		Content: []protocol.Content{
-			&protocol.TextContent{
-				Type: "text",
-				Text: string(jsonOutput),
-			},
-		},
-	}, nil
+	return result
 }
 
 // handleGetLogs implements the get_logs tool
@@ -174,10 +179,16 @@ func (s *MCPServer) handleGetLogs(ctx context.Context, request *protocol.CallToo
 	if args.Lines > 10000 {
 		args.Lines = 10000
 	}
+	if args.Stream != "" && args.Stream != streamStdout && args.Stream != streamStderr {
+		return nil, fmt.Errorf("invalid stream %q: expected 'stdout', 'stderr' or empty", args.Stream)
+	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	// Match containers by name, or get ALL containers if none specified
 	var containers []types.Container
-	var err error
 
 	if len(args.Containers) == 0 {
 		// No containers specified - search across ALL containers
@@ -194,6 +205,9 @@ func (s *MCPServer) handleGetLogs(ctx context.Context, request *protocol.CallToo
 	}
 
 	if len(containers) == 0 {
+		if jsonFormat {
+			return jsonResult(GetLogsResult{Containers: []ContainerLogs{}})
+		}
 		return &protocol.CallToolResult{
 			Content: []protocol.Content{
 				&protocol.TextContent{
@@ -220,40 +234,48 @@ func (s *MCPServer) handleGetLogs(ctx context.Context, request *protocol.CallToo
 	}
 
 	tailLines := fmt.Sprintf("%d", args.Lines)
-	logsMap := s.logBroker.FetchRecentLogs(containerIDs, tailLines)
+	entriesMap := s.logBroker.FetchRecentEntries(containerIDs, tailLines)
 
 	// Build output
 	var output strings.Builder
+	jsonOutput := GetLogsResult{Containers: []ContainerLogs{}}
 	for _, c := range containers {
 		name := getContainerName(c)
 
-		logLines, ok := logsMap[c.ID]
-		if !ok || len(logLines) == 0 {
+		entries, ok := entriesMap[c.ID]
+		if !ok || len(entries) == 0 {
 			// Skip containers with no logs when filtering
 			if args.Filter != "" {
 				continue
 			}
+			jsonOutput.Containers = append(jsonOutput.Containers, ContainerLogs{ID: c.ID[:12], Name: name, Lines: []LogLine{}})
 			output.WriteString(fmt.Sprintf("=== Container: %s ===\n", name))
 			output.WriteString("(no logs available)\n\n")
 			continue
 		}
 
-		// Filter logs if requested
-		filtered := logLines
-		if args.Filter != "" {
-			filtered = []string{}
-			for _, line := range logLines {
-				content := stripAnsiCodes(line)
+		// Filter logs by stream and keyword if requested
+		filtered := []string{}
+		lines := []LogLine{}
+		for _, entry := range entries {
+			if args.Stream != "" && entry.Stream != args.Stream {
+				continue
+			}
+			if args.Filter != "" {
+				content := stripAnsiCodes(entry.Line)
 				if filterRegex != nil {
-					if filterRegex.MatchString(content) {
-						filtered = append(filtered, line)
-					}
-				} else {
-					if strings.Contains(strings.ToLower(content), strings.ToLower(args.Filter)) {
-						filtered = append(filtered, line)
+					if !filterRegex.MatchString(content) {
+						continue
 					}
+				} else if !strings.Contains(strings.ToLower(content), strings.ToLower(args.Filter)) {
+					continue
 				}
 			}
+			filtered = append(filtered, formatMCPLogLine(entry, args.Stream))
+			lines = append(lines, mcpLogLine(entry))
+		}
+		if len(filtered) > 0 || args.Filter == "" {
+			jsonOutput.Containers = append(jsonOutput.Containers, ContainerLogs{ID: c.ID[:12], Name: name, Lines: lines})
 		}
 
 		// Skip containers with no matching logs when filtering
@@ -271,6 +293,9 @@ func (s *MCPServer) handleGetLogs(ctx context.Context, request *protocol.CallToo
 		}
 	}
 
+	if jsonFormat {
+		return jsonResult(jsonOutput)
+	}
 	return &protocol.CallToolResult{
 		Content: []protocol.Content{
 			&protocol.TextContent{
@@ -281,6 +306,23 @@ func (s *MCPServer) handleGetLogs(ctx context.Context, request *protocol.CallToo
 	}, nil
 }
 
+// formatMCPLogLine returns a get_logs line, marking stderr lines when both streams are returned
+func formatMCPLogLine(entry LogEntry, stream string) string {
+	if stream == "" && entry.Stream == streamStderr {
+		return "[stderr] " + entry.Line
+	}
+	return entry.Line
+}
+
+// mcpLogLine returns the get_logs JSON line of an entry
+func mcpLogLine(entry LogEntry) LogLine {
+	line := LogLine{Stream: entry.Stream, Line: stripAnsiCodes(entry.Line)}
+	if !entry.Timestamp.IsZero() {
+		line.Time = entry.Timestamp.UTC().Format(time.RFC3339Nano)
+	}
+	return line
+}
+
 // handleGetStats implements the get_stats tool
 func (s *MCPServer) handleGetStats(ctx context.Context, request *protocol.CallToolRequest) (*protocol.CallToolResult, error) {
 	// Record MCP activity
@@ -292,6 +334,10 @@ func (s *MCPServer) handleGetStats(ctx context.Context, request *protocol.CallTo
 	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	// Match containers
 	containers, err := matchContainersByName(s.dockerClient, args.Containers)
@@ -300,6 +346,9 @@ func (s *MCPServer) handleGetStats(ctx context.Context, request *protocol.CallTo
 	}
 
 	if len(containers) == 0 {
+		if jsonFormat {
+			return jsonResult(GetStatsResult{Containers: []ContainerStatsInfo{}})
+		}
 		return &protocol.CallToolResult{
 			Content: []protocol.Content{
 				&protocol.TextContent{
@@ -314,18 +363,7 @@ func (s *MCPServer) handleGetStats(ctx context.Context, request *protocol.CallTo
 	cpuStats, _ := fetchCPUStatsSync(s.dockerClient, containers)
 
 	// Build result
-	type StatsInfo struct {
-		ID         string    `json:"id"`
-		Name       string    `json:"name"`
-		State      string    `json:"state"`
-		CPUPercent string    `json:"cpu_percent"`
-		CPUHistory []float64 `json:"cpu_history,omitempty"`
-		LogRate    string    `json:"log_rate"`
-		Status     string    `json:"status"`
-		Ports      string    `json:"ports"`
-	}
-
-	var result []StatsInfo
+	var result []ContainerStatsInfo
 	for _, c := range containers {
 		name := getContainerName(c)
 
@@ -346,7 +384,7 @@ func (s *MCPServer) handleGetStats(ctx context.Context, request *protocol.CallTo
 			logRate = fmt.Sprintf("%.1f", rate)
 		}
 
-		result = append(result, StatsInfo{
+		info := ContainerStatsInfo{
 			ID:         c.ID[:12],
 			Name:       name,
 			State:      c.State,
@@ -355,22 +393,46 @@ func (s *MCPServer) handleGetStats(ctx context.Context, request *protocol.CallTo
 			LogRate:    logRate,
 			Status:     c.Status,
 			Ports:      formatPortsForMCP(c.Ports),
-		})
-	}
+		}
 
-	jsonOutput, err := json.MarshalIndent(result, "", "  ")
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal result: %w", err)
+		// Error rates over 1m/5m windows
+		errorStats := ErrorStats{}
+		if s.rateTracker != nil {
+			errorStats = s.rateTracker.GetErrorStats(c.ID)
+		}
+		info.ErrorsPerMin1m = fmt.Sprintf("%.0f", errorStats.PerMinute1m)
+		info.ErrorsPerMin5m = fmt.Sprintf("%.1f", errorStats.PerMinute5m)
+		if args.History {
+			info.ErrorHistory = errorStats.History
+		}
+
+		// Memory, network, block I/O and PIDs
+		if s.statsCache != nil {
+			if stats, ok := s.statsCache.GetForContainer(c.ID); ok {
+				info.MemoryUsage = formatBytes(stats.MemUsage)
+				info.MemoryLimit = formatBytes(stats.MemLimit)
+				info.MemoryPercent = fmt.Sprintf("%.1f", stats.MemPercent)
+				info.NetRxRate = formatBytes(uint64(stats.NetRxRate)) + "/s"
+				info.NetTxRate = formatBytes(uint64(stats.NetTxRate)) + "/s"
+				info.BlockReadRate = formatBytes(uint64(stats.BlockReadRate)) + "/s"
+				info.BlockWriteRate = formatBytes(uint64(stats.BlockWriteRate)) + "/s"
+				info.PIDs = stats.PIDs
+				if args.History {
+					for _, sample := range s.statsCache.GetHistory(c.ID) {
+						info.MemoryHistory = append(info.MemoryHistory, sample.MemUsage)
+					}
+				}
+			}
+		}
+
+		result = append(result, info)
 	}
 
-	return &protocol.CallToolResult{
-		Content: []protocol.Content{
-			&protocol.TextContent{
-				Type: "text",
-				Text: string(jsonOutput),
-			},
-		},
-	}, nil
+	// Text format: the bare array
+	if jsonFormat {
+		return jsonResult(GetStatsResult{Containers: result})
+	}
+	return jsonResult(result)
 }
 
 // handleStartContainer implements the start_container tool
@@ -384,13 +446,17 @@ func (s *MCPServer) handleStartContainer(ctx context.Context, request *protocol.
 	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	containers, err := matchContainersByName(s.dockerClient, args.Containers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to match containers: %w", err)
 	}
 
-	if len(containers) == 0 {
+	if len(containers) == 0 && !jsonFormat {
 		return &protocol.CallToolResult{
 			Content: []protocol.Content{
 				&protocol.TextContent{
@@ -401,29 +467,20 @@ func (s *MCPServer) handleStartContainer(ctx context.Context, request *protocol.
 		}, nil
 	}
 
-	var results []string
+	results := []ContainerActionStatus{}
 	for _, c := range containers {
-		name := getContainerName(c)
+		status := ContainerActionStatus{ID: c.ID[:12], Name: getContainerName(c), Action: "start"}
 		if c.State == "running" {
-			results = append(results, fmt.Sprintf("✓ %s: already running", name))
-			continue
-		}
-
-		if err := s.dockerClient.ContainerStart(ctx, c.ID, container.StartOptions{}); err != nil {
-			results = append(results, fmt.Sprintf("✗ %s: %v", name, err))
+			status.Status, status.Success, status.Message = "already_running", true, "already running"
+		} else if err := s.dockerClient.ContainerStart(ctx, c.ID, container.StartOptions{}); err != nil {
+			status.Status, status.Message = "failed", err.Error()
 		} else {
-			results = append(results, fmt.Sprintf("✓ %s: started successfully", name))
+			status.Status, status.Success, status.Message = "started", true, "started successfully"
 		}
+		results = append(results, status)
 	}
 
-	return &protocol.CallToolResult{
-		Content: []protocol.Content{
-			&protocol.TextContent{
-				Type: "text",
-				Text: strings.Join(results, "\n"),
-			},
-		},
-	}, nil
+	return containerActionResult(results, jsonFormat)
 }
 
 // handleStopContainer implements the stop_container tool
@@ -437,13 +494,17 @@ func (s *MCPServer) handleStopContainer(ctx context.Context, request *protocol.C
 	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	containers, err := matchContainersByName(s.dockerClient, args.Containers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to match containers: %w", err)
 	}
 
-	if len(containers) == 0 {
+	if len(containers) == 0 && !jsonFormat {
 		return &protocol.CallToolResult{
 			Content: []protocol.Content{
 				&protocol.TextContent{
@@ -455,29 +516,20 @@ func (s *MCPServer) handleStopContainer(ctx context.Context, request *protocol.C
 	}
 
 	timeout := 10
-	var results []string
+	results := []ContainerActionStatus{}
 	for _, c := range containers {
-		name := getContainerName(c)
+		status := ContainerActionStatus{ID: c.ID[:12], Name: getContainerName(c), Action: "stop"}
 		if c.State != "running" {
-			results = append(results, fmt.Sprintf("✓ %s: already stopped", name))
-			continue
-		}
-
-		if err := s.dockerClient.ContainerStop(ctx, c.ID, container.StopOptions{Timeout: &timeout}); err != nil {
-			results = append(results, fmt.Sprintf("✗ %s: %v", name, err))
+			status.Status, status.Success, status.Message = "already_stopped", true, "already stopped"
+		} else if err := s.dockerClient.ContainerStop(ctx, c.ID, container.StopOptions{Timeout: &timeout}); err != nil {
+			status.Status, status.Message = "failed", err.Error()
 		} else {
-			results = append(results, fmt.Sprintf("✓ %s: stopped successfully", name))
+			status.Status, status.Success, status.Message = "stopped", true, "stopped successfully"
 		}
+		results = append(results, status)
 	}
 
-	return &protocol.CallToolResult{
-		Content: []protocol.Content{
-			&protocol.TextContent{
-				Type: "text",
-				Text: strings.Join(results, "\n"),
-			},
-		},
-	}, nil
+	return containerActionResult(results, jsonFormat)
 }
 
 // handleRestartContainer implements the restart_container tool
@@ -491,13 +543,17 @@ func (s *MCPServer) handleRestartContainer(ctx context.Context, request *protoco
 	if err := protocol.VerifyAndUnmarshal(request.RawArguments, args); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %w", err)
 	}
+	jsonFormat, err := parseOutputFormat(args.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
 
 	containers, err := matchContainersByName(s.dockerClient, args.Containers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to match containers: %w", err)
 	}
 
-	if len(containers) == 0 {
+	if len(containers) == 0 && !jsonFormat {
 		return &protocol.CallToolResult{
 			Content: []protocol.Content{
 				&protocol.TextContent{
@@ -509,21 +565,41 @@ func (s *MCPServer) handleRestartContainer(ctx context.Context, request *protoco
 	}
 
 	timeout := 10
-	var results []string
+	results := []ContainerActionStatus{}
 	for _, c := range containers {
-		name := getContainerName(c)
+		status := ContainerActionStatus{ID: c.ID[:12], Name: getContainerName(c), Action: "restart"}
 		if err := s.dockerClient.ContainerRestart(ctx, c.ID, container.StopOptions{Timeout: &timeout}); err != nil {
-			results = append(results, fmt.Sprintf("✗ %s: %v", name, err))
+			status.Status, status.Message = "failed", err.Error()
 		} else {
-			results = append(results, fmt.Sprintf("✓ %s: restarted successfully", name))
+			status.Status, status.Success, status.Message = "restarted", true, "restarted successfully"
 		}
+		results = append(results, status)
+	}
+
+	return containerActionResult(results, jsonFormat)
+}
+
+// containerActionResult returns the result of a container action tool: one "✓ name: message"
+// line per container, or a ContainerActionResult in the JSON format
+func containerActionResult(results []ContainerActionStatus, jsonFormat bool) (*protocol.CallToolResult, error) {
+	if jsonFormat {
+		return jsonResult(ContainerActionResult{Results: results})
+	}
+
+	lines := make([]string, len(results))
+	for i, status := range results {
+		mark := "✓"
+		if !status.Success {
+			mark = "✗"
+		}
+		lines[i] = fmt.Sprintf("%s %s: %s", mark, status.Name, status.Message)
 	}
 
 	return &protocol.CallToolResult{
 		Content: []protocol.Content{
 			&protocol.TextContent{
 				Type: "text",
-				Text: strings.Join(results, "\n"),
+				Text: strings.Join(lines, "\n"),
 			},
 		},
 	}, nil
@@ -531,6 +607,23 @@ func (s *MCPServer) handleRestartContainer(ctx context.Context, request *protoco
 
 // Helper functions
 
+// jsonResult returns a tool result with v as indented JSON text
+func jsonResult(v any) (*protocol.CallToolResult, error) {
+	jsonOutput, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return &protocol.CallToolResult{
+		Content: []protocol.Content{
+			&protocol.TextContent{
+				Type: "text",
+				Text: string(jsonOutput),
+			},
+		},
+	}, nil
+}
+
 // loadContainersSync loads all containers synchronously
 func loadContainersSync(cli *client.Client) ([]types.Container, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -1,28 +1,186 @@
 package main
 
+import "fmt"
+
 // GetLogsArgs defines arguments for the get_logs tool
 type GetLogsArgs struct {
-	Containers []string `json:"containers,omitempty" description:"Container names or IDs (supports partial matches). Leave empty to search across ALL containers."`
-	Filter     string   `json:"filter,omitempty" description:"Keyword or regex pattern to filter log lines"`
-	IsRegex    bool     `json:"is_regex,omitempty" description:"Treat filter as regex (default: false, substring search)"`
-	Lines      int      `json:"lines,omitempty" description:"Maximum lines per container (default: 100, max: 10000)"`
-	Tail       bool     `json:"tail,omitempty" description:"Return most recent lines (default: true)"`
+	Containers   []string `json:"containers,omitempty" description:"Container names or IDs (supports partial matches). Leave empty to search across ALL containers."`
+	Filter       string   `json:"filter,omitempty" description:"Keyword or regex pattern to filter log lines"`
+	IsRegex      bool     `json:"is_regex,omitempty" description:"Treat filter as regex (default: false, substring search)"`
+	Lines        int      `json:"lines,omitempty" description:"Maximum lines per container (default: 100, max: 10000)"`
+	Tail         bool     `json:"tail,omitempty" description:"Return most recent lines (default: true)"`
+	Stream       string   `json:"stream,omitempty" description:"Output stream: 'stdout', 'stderr' or empty for both (stderr lines are marked [stderr])"`
+	OutputFormat string   `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
 }
 
 // ListContainersArgs defines arguments for the list_containers tool
 type ListContainersArgs struct {
-	All         bool   `json:"all,omitempty" description:"Include stopped containers (default: false, only running)"`
-	NameFilter  string `json:"name_filter,omitempty" description:"Filter by container name (case-insensitive substring)"`
-	StateFilter string `json:"state_filter,omitempty" description:"Filter by state (running, exited, paused, restarting, etc.)"`
+	All          bool   `json:"all,omitempty" description:"Include stopped containers (default: false, only running)"`
+	NameFilter   string `json:"name_filter,omitempty" description:"Filter by container name (case-insensitive substring)"`
+	StateFilter  string `json:"state_filter,omitempty" description:"Filter by state (running, exited, paused, restarting, etc.)"`
+	OutputFormat string `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
 }
 
 // GetStatsArgs defines arguments for the get_stats tool
 type GetStatsArgs struct {
-	Containers []string `json:"containers" description:"Container names or IDs (supports partial matches)"`
-	History    bool     `json:"history,omitempty" description:"Include 10-value CPU history (default: false)"`
+	Containers   []string `json:"containers" description:"Container names or IDs (supports partial matches)"`
+	History      bool     `json:"history,omitempty" description:"Include 10-value CPU and memory history and the 5-minute error history (default: false)"`
+	OutputFormat string   `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
+}
+
+// FollowLogsArgs defines arguments for the follow_logs tool
+type FollowLogsArgs struct {
+	Containers      []string `json:"containers,omitempty" description:"Container names or IDs (supports partial matches). Leave empty to follow ALL containers, including ones started later."`
+	Filter          string   `json:"filter,omitempty" description:"Keyword or regex pattern lines must match"`
+	IsRegex         bool     `json:"is_regex,omitempty" description:"Treat filter as regex (default: false, substring search)"`
+	Stream          string   `json:"stream,omitempty" description:"Output stream: 'stdout', 'stderr' or empty for both"`
+	DurationSeconds int      `json:"duration_seconds,omitempty" description:"Subscription lifetime in seconds (default: 600, max: 3600)"`
+	OutputFormat    string   `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
+}
+
+// UnfollowLogsArgs defines arguments for the unfollow_logs tool
+type UnfollowLogsArgs struct {
+	SubscriptionID string `json:"subscription_id,omitempty" description:"Subscription returned by follow_logs. Leave empty to stop all subscriptions of this session."`
+	OutputFormat   string `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
+}
+
+// InspectContainerArgs defines arguments for the inspect_container tool
+type InspectContainerArgs struct {
+	Containers   []string `json:"containers" description:"Container names or IDs to inspect (supports partial matches)"`
+	OutputFormat string   `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
+}
+
+// ExecCommandArgs defines arguments for the exec_command tool
+type ExecCommandArgs struct {
+	Container    string `json:"container" description:"Exact container name or ID (at least 12 characters); partial matches are only suggested"`
+	Command      string `json:"command" description:"Command to run, e.g. 'cat /etc/nginx/nginx.conf'. Quotes group words; no shell: pipes, redirections and variables are not interpreted"`
+	OutputFormat string `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
 }
 
 // ContainerActionArgs defines arguments for container action tools (start, stop, restart)
 type ContainerActionArgs struct {
-	Containers []string `json:"containers" description:"Container names or IDs to act on (supports partial matches)"`
+	Containers   []string `json:"containers" description:"Container names or IDs to act on (supports partial matches)"`
+	OutputFormat string   `json:"output_format,omitempty" description:"Result format: 'text' (default) or 'json' (stable schema, see README)"`
+}
+
+// Output formats of the MCP tools (output_format argument)
+const (
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+)
+
+// parseOutputFormat validates the output_format argument and reports whether JSON was requested
+func parseOutputFormat(format string) (bool, error) {
+	switch format {
+	case "", outputFormatText:
+		return false, nil
+	case outputFormatJSON:
+		return true, nil
+	}
+	return false, fmt.Errorf("invalid output_format %q: expected 'text' or 'json'", format)
+}
+
+// JSON results (output_format "json"): one object per tool with a top-level array, empty
+// (never null) when no container matches. Field names are part of the schema; only add fields.
+
+// ContainerSummary is a list_containers entry
+type ContainerSummary struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	State      string `json:"state"`
+	Status     string `json:"status"`
+	CPUPercent string `json:"cpu_percent"`
+	LogRate    string `json:"log_rate"`
+	ErrorRate  string `json:"errors_per_min"`
+	Ports      string `json:"ports"`
+}
+
+// ListContainersResult is the JSON result of list_containers
+type ListContainersResult struct {
+	Containers []ContainerSummary `json:"containers"`
+}
+
+// LogLine is a get_logs line (ANSI codes stripped)
+type LogLine struct {
+	Time   string `json:"time,omitempty"` // RFC 3339 time recorded by Docker
+	Stream string `json:"stream,omitempty"`
+	Line   string `json:"line"`
+}
+
+// ContainerLogs holds the get_logs lines of one container
+type ContainerLogs struct {
+	ID    string    `json:"id"`
+	Name  string    `json:"name"`
+	Lines []LogLine `json:"lines"`
+}
+
+// GetLogsResult is the JSON result of get_logs
+type GetLogsResult struct {
+	Containers []ContainerLogs `json:"containers"`
+}
+
+// ContainerStatsInfo is a get_stats entry
+type ContainerStatsInfo struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	State      string    `json:"state"`
+	CPUPercent string    `json:"cpu_percent"`
+	CPUHistory []float64 `json:"cpu_history,omitempty"`
+	LogRate    string    `json:"log_rate"`
+	Status     string    `json:"status"`
+	Ports      string    `json:"ports"`
+
+	// Error lines (ERROR/FATAL or --error-pattern) per minute
+	ErrorsPerMin1m string `json:"errors_per_min_1m"`
+	ErrorsPerMin5m string `json:"errors_per_min_5m"`
+	ErrorHistory   []int  `json:"error_history,omitempty"` // Errors per 30s over 5 minutes
+
+	// Resource usage from the stats cache (empty until the TUI has sampled the container)
+	MemoryUsage    string   `json:"memory_usage,omitempty"`
+	MemoryLimit    string   `json:"memory_limit,omitempty"`
+	MemoryPercent  string   `json:"memory_percent,omitempty"`
+	MemoryHistory  []uint64 `json:"memory_history,omitempty"`
+	NetRxRate      string   `json:"net_rx_rate,omitempty"`
+	NetTxRate      string   `json:"net_tx_rate,omitempty"`
+	BlockReadRate  string   `json:"block_read_rate,omitempty"`
+	BlockWriteRate string   `json:"block_write_rate,omitempty"`
+	PIDs           uint64   `json:"pids,omitempty"`
+}
+
+// GetStatsResult is the JSON result of get_stats
+type GetStatsResult struct {
+	Containers []ContainerStatsInfo `json:"containers"`
+}
+
+// FollowLogsResult is the JSON result of follow_logs
+type FollowLogsResult struct {
+	SubscriptionID string   `json:"subscription_id"`
+	Containers     []string `json:"containers"` // Empty: all containers
+	ExpiresAt      string   `json:"expires_at"`
+}
+
+// UnfollowLogsResult is the JSON result of unfollow_logs
+type UnfollowLogsResult struct {
+	Unsubscribed int `json:"unsubscribed"`
+}
+
+// InspectContainerResult is the JSON result of inspect_container
+type InspectContainerResult struct {
+	Containers []InspectInfo `json:"containers"`
+}
+
+// ContainerActionStatus is the outcome of start_container, stop_container or restart_container
+// for one container; Message is the text shown in the text format
+type ContainerActionStatus struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Action  string `json:"action"` // start, stop, restart
+	Status  string `json:"status"` // started, already_running, stopped, already_stopped, restarted, failed
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+// ContainerActionResult is the JSON result of the container action tools
+type ContainerActionResult struct {
+	Results []ContainerActionStatus `json:"results"`
 }
@@ -22,6 +22,10 @@ const (
 	confirmView
 	exitConfirmView
 	mcpLogsView
+	inspectView
+	imagesView
+	volumesView
+	networksView
 )
 
 // Messages
@@ -62,6 +66,7 @@ type model struct {
 	cpuStats       map[string][]float64                // CPU history per container (last 10 values)
 	cpuCurrent     map[string]float64                  // Current CPU percentage per container
 	cpuPrevStats   map[string]*container.StatsResponse // Previous stats for delta calculation
+	statsCurrent   map[string]ContainerStats           // Current memory/network/block/PID stats per container
 	filterMode     bool                                // true when typing filter
 	filterInput    string                              // filter text being typed
 	filterActive   string                              // currently applied filter
@@ -69,13 +74,21 @@ type model struct {
 	filterIsRegex     bool                                // true if it's a valid regex
 	demoMode          bool                                // true when launched with --demo flag
 	debugMonitor      bool                                // true when launched with --debug-monitor flag
+	doubleClickShell  bool                                // true when double-click opens a shell instead of logs
 	logsBufferLength  int                                 // Maximum log lines in buffer (default 10000)
+	viewerFiles       []string                            // Files shown by "docker-tui view" (nil when connected to Docker)
 
 	// LogBroker architecture (permanent streaming)
-	logBroker   *LogBroker           // Central broker for all logs
-	rateTracker *RateTrackerConsumer // Permanent tracker for L/S column
-	mcpServer   *MCPServer           // MCP server instance (nil if not running)
-	cpuCache    *CPUStatsCache       // Shared CPU cache for MCP instant responses
+	logBroker    *LogBroker           // Central broker for all logs
+	rateTracker  *RateTrackerConsumer // Permanent tracker for L/S column
+	mcpServer    *MCPServer           // MCP server instance (nil if not running)
+	statsCache   *StatsCache          // Shared stats cache for MCP instant responses
+	eventWatcher *EventWatcher        // Docker events subscription (nil = fallback to polling)
+
+	// Multi-host: the fields above point to the active host
+	hosts        []*dockerHost          // Connected Docker hosts (--context / --host), nil in tests
+	activeHost   int                    // Index of the active host in hosts
+	eventWaiting map[*EventWatcher]bool // Watchers with a pending waitForDockerEvent command
 
 	// BufferConsumer for logsView (temporary)
 	bufferConsumer       *BufferConsumer // Buffer for logsView (nil when not in logsView)
@@ -84,15 +97,66 @@ type model struct {
 	logsViewMaxNameWidth int             // Maximum container name width for alignment
 	logsViewPaused       bool            // True when user scrolled up (pause auto-scroll)
 	logsColorEnabled     bool            // True to show colored backgrounds in logs (default: true)
+	logsFilterRegex      bool            // True when logs filter terms are regular expressions
+	logFilter            *logFilter      // Parsed logs filter (cache, see activeLogFilter)
+	logsHighlightMode    bool            // True to highlight filter matches instead of hiding other lines
+	logsMatchKey         *logEntryKey    // Entry of the current match in highlight mode (nil = none)
+	logsTimestampMode    logTimestampMode // Timestamp column of the logs view (T key)
+	logsStderrOnly       bool            // True to show only stderr lines in the logs view (E key)
+	logsPrettyMode       bool            // True to show structured (JSON/logfmt) lines as level/time/msg columns
+	logsMinLevel         logLevel        // Minimum level shown in the logs view (levelUnknown = all lines)
+	logsExportMode       bool            // true when typing the path of a logs export (S key)
+	logsExportInput      string          // Export path being typed
+	logsExportFormat     logExportFormat // Format of the next export (TAB in the prompt)
 	newLogChan           chan struct{}   // Channel to notify new log arrivals
 	logChanClosing       atomic.Bool     // Atomic flag to prevent panic on closed channel
 	logChanWg            sync.WaitGroup  // WaitGroup to ensure all callbacks complete before closing channel
 	logChanCloseOnce     sync.Once       // Ensures channel is closed only once (CRITICAL FIX)
 	wasAtBottom          bool            // True if we were scrolled to bottom before last log arrival
 
+	// Inspect view state
+	inspectContainerID   string           // Container being inspected
+	inspectContainerName string           // Display name of the inspected container
+	inspectSections      []inspectSection // Sections built from the last ContainerInspect result
+	inspectErr           error            // Error of the last ContainerInspect call
+	inspectLoading       bool             // True while ContainerInspect is in flight
+	inspectCursor        int              // Cursor position in the flattened rows
+	inspectScroll        int              // Scroll offset of the flattened rows
+	inspectCollapsed     map[string]bool  // Collapsed sections by title (kept across containers)
+
+	// Images view state
+	imageRows       []imageRow      // Rows of the images view (one per repo:tag)
+	imagesCursor    int             // Cursor position in imageRows
+	imagesSelected  map[string]bool // Selected image refs (same semantics as selected)
+	imagesLoading   bool            // True while ImageList is in flight
+	imagePull       *imagePullState // Pull in progress (nil when idle)
+	pullPromptMode  bool            // true when typing the reference to pull
+	pullPromptInput string          // Reference being typed
+
+	// Compose grouping (list view)
+	groupByProject    bool            // true when the list is grouped by compose project
+	collapsedProjects map[string]bool // Collapsed project headers in grouped mode
+
+	// Volumes view state
+	volumeRows      []volumeRow     // Rows of the volumes view
+	volumesCursor   int             // Cursor position in volumeRows
+	volumesSelected map[string]bool // Selected volume names
+	volumesLoading  bool            // True while VolumeList is in flight
+
+	// Networks view state
+	networkRows      []networkRow    // Rows of the networks view
+	networksCursor   int             // Cursor position in networkRows
+	networksSelected map[string]bool // Selected network IDs
+	networksLoading  bool            // True while NetworkList is in flight
+	pendingNetworkID string          // Network of a pending connect/disconnect confirmation
+
+	// Confirmation dialog target for non-container views
+	confirmReturnView viewMode // View to return to after confirmView (listView for containers)
+	pendingTargets    []string // Targets of pendingAction captured when the dialog was opened
+
 	// Mutexes for concurrent access
 	containersMu     sync.RWMutex // CRITICAL FIX: Protects containers slice from concurrent access
-	cpuStatsMu       sync.RWMutex // Protects cpuStats, cpuCurrent, cpuPrevStats maps
+	cpuStatsMu       sync.RWMutex // Protects cpuStats, cpuCurrent, cpuPrevStats, statsCurrent maps
 	processingMu     sync.RWMutex // Protects processing map
 	selectedMu       sync.RWMutex // CRITICAL FIX: Protects selected map from concurrent access
 	viewTransitionMu sync.Mutex   // CRITICAL FIX: Protects view mode transitions and log channel lifecycle
@@ -124,14 +188,26 @@ func (m *model) Init() tea.Cmd {
 	// LogBroker and RateTracker are already initialized in main.go
 	// Streaming will start automatically in containerListMsg after loading
 
-	return tea.Batch(
+	// Offline viewer: the logs are already loaded, there is no daemon to poll
+	if m.viewerFiles != nil {
+		return nil
+	}
+
+	cmds := []tea.Cmd{
 		loadContainers(m.dockerClient),
 		tickCmd(),
 		cpuTickCmd(),
 		logRateTickCmd(),
 		cleanupTickCmd(),
 		cpuCleanupTickCmd(),
-	)
+	}
+
+	// Container state changes are pushed by the EventWatcher when available
+	if m.eventWatcher != nil {
+		cmds = append(cmds, m.waitForActiveEvents(), statusRefreshTickCmd())
+	}
+
+	return tea.Batch(cmds...)
 }
 
 // logRateTickMsg to refresh the display of log rates
@@ -175,6 +251,10 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		// Logs view: stay at the bottom when the number of visible lines changes
+		if m.view == logsView && m.bufferConsumer != nil && m.wasAtBottom {
+			m.logsViewScroll = max(0, m.getFilteredLogCount()-(m.height-5))
+		}
 		// Force a full redraw after resize by clearing screen
 		return m, tea.ClearScreen
 
@@ -190,12 +270,16 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.containers = []types.Container(msg)
 
 		// CRITICAL FIX: Adjust cursor if out of bounds after container removal
-		if m.cursor >= len(m.containers) && len(m.containers) > 0 {
+		// (in grouped mode rows include project headers: clamped on rows once unlocked)
+		if !m.groupByProject && m.cursor >= len(m.containers) && len(m.containers) > 0 {
 			m.cursor = len(m.containers) - 1
 		} else if len(m.containers) == 0 {
 			m.cursor = 0
 		}
 		m.containersMu.Unlock()
+		if m.groupByProject {
+			m.clampListCursor()
+		}
 
 		// Cleanup removed containers from maps to prevent memory leak
 		currentIDs := make(map[string]bool)
@@ -212,6 +296,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				delete(m.cpuStats, id)
 				delete(m.cpuCurrent, id)
 				delete(m.cpuPrevStats, id)
+				delete(m.statsCurrent, id)
 			}
 		}
 		m.cpuStatsMu.Unlock()
@@ -236,80 +321,121 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.logBroker.StartStreaming(containersCopy)
 		}
 
-		// CRITICAL FIX: Also protect read of m.cpuPrevStats with mutex (prevents race condition)
-		m.cpuStatsMu.RLock()
-		prevStatsCopy := make(map[string]*container.StatsResponse, len(m.cpuPrevStats))
-		for k, v := range m.cpuPrevStats {
-			prevStatsCopy[k] = v
-		}
-		m.cpuStatsMu.RUnlock()
-
 		// Fetch CPU stats after loading containers
-		return m, fetchCPUStats(m.dockerClient, containersCopy, prevStatsCopy)
+		return m, m.fetchHostCPUStats(m.activeHost)
 
 	case cpuStatsMsg:
 		// Update CPU stats and store raw stats for next delta calculation (thread-safe)
+		// Multi-host: stats of another host (background, or polled before a switch) go to that host
 		m.cpuStatsMu.Lock()
-		for containerID, cpuPercent := range msg.stats {
-			// Update current value
-			m.cpuCurrent[containerID] = cpuPercent
-
-			// Add to history (keep last 10 values)
-			history := m.cpuStats[containerID]
-			history = append(history, cpuPercent)
-			if len(history) > 10 {
-				history = history[1:]
+		stats, cache := m.activeStats(), m.statsCache
+		if msg.host != m.activeHost {
+			if msg.host < 0 || msg.host >= len(m.hosts) {
+				m.cpuStatsMu.Unlock()
+				return m, nil
 			}
-			m.cpuStats[containerID] = history
-		}
-
-		// Store raw stats as previous for next iteration
-		for containerID, rawStats := range msg.rawStats {
-			m.cpuPrevStats[containerID] = rawStats
+			host := m.hosts[msg.host]
+			if host.stats == nil {
+				host.stats = newHostStats()
+			}
+			stats, cache = host.stats, host.statsCache
 		}
-
-		// CRITICAL: Update shared CPU cache for instant MCP responses
-		// Make a copy of cpuCurrent to avoid holding the lock while updating cache
-		cpuCurrentCopy := make(map[string]float64, len(m.cpuCurrent))
-		for k, v := range m.cpuCurrent {
-			cpuCurrentCopy[k] = v
+		statsCopy := stats.apply(msg)
+		if msg.host == m.activeHost {
+			m.setActiveStats(stats)
 		}
 		m.cpuStatsMu.Unlock()
 
+		// CRITICAL: Update shared stats cache for instant MCP responses
 		// Update cache outside of lock to avoid potential deadlock
-		if m.cpuCache != nil {
-			m.cpuCache.Update(cpuCurrentCopy)
+		if cache != nil {
+			cache.Update(statsCopy)
 		}
 
 		return m, nil
 
 	case tickMsg:
 		m.spinnerFrame = (m.spinnerFrame + 1) % len(spinnerFrames)
+		// Poll the container list only when no events subscription is available
+		if m.eventWatcher != nil {
+			return m, tickCmd()
+		}
 		return m, tea.Batch(
-			loadContainers(m.dockerClient),
+			m.reloadContainers(),
 			tickCmd(),
 		)
 
-	case cpuTickMsg:
-		// CRITICAL FIX: Protect read of m.containers with mutex to prevent race condition
-		m.containersMu.RLock()
-		containersCopy := make([]types.Container, len(m.containers))
-		copy(containersCopy, m.containers)
-		m.containersMu.RUnlock()
+	case statusRefreshTickMsg:
+		// Events only fire on state changes: reload occasionally so "Up X minutes" stays current
+		return m, tea.Batch(
+			m.reloadContainers(),
+			statusRefreshTickCmd(),
+		)
 
-		// CRITICAL FIX: Also protect read of m.cpuPrevStats with mutex
-		m.cpuStatsMu.RLock()
-		prevStatsCopy := make(map[string]*container.StatsResponse, len(m.cpuPrevStats))
-		for k, v := range m.cpuPrevStats {
-			prevStatsCopy[k] = v
+	case hostContainerListMsg:
+		// Multi-host: ignore lists loaded before a host switch
+		if msg.host != m.activeHost {
+			return m, nil
 		}
-		m.cpuStatsMu.RUnlock()
+		return m.Update(containerListMsg(msg.containers))
 
+	case dockerResyncMsg:
+		// Changes of a background host: its EventWatcher keeps its own list
+		if !m.acceptEvent(msg.source) {
+			return m, nil
+		}
+		// Full list after (re)connecting to the events stream: reuse the containerListMsg path
+		_, cmd := m.Update(containerListMsg(msg.containers))
+		return m, tea.Batch(cmd, m.waitForActiveEvents())
+
+	case containerEventMsg:
+		if !m.acceptEvent(msg.source) {
+			return m, nil
+		}
+		// Incremental update from the events stream
+		m.containersMu.Lock()
+		m.containers = applyContainerEvent(m.containers, msg.containerID, msg.container)
+		// (in grouped mode rows include project headers: clamped on rows once unlocked)
+		if !m.groupByProject && m.cursor >= len(m.containers) && len(m.containers) > 0 {
+			m.cursor = len(m.containers) - 1
+		} else if len(m.containers) == 0 {
+			m.cursor = 0
+		}
+		m.containersMu.Unlock()
+		if m.groupByProject {
+			m.clampListCursor()
+		}
+
+		// Destroyed container: drop per-container state to prevent memory leak
+		if msg.container == nil {
+			m.cpuStatsMu.Lock()
+			delete(m.cpuStats, msg.containerID)
+			delete(m.cpuCurrent, msg.containerID)
+			delete(m.cpuPrevStats, msg.containerID)
+			delete(m.statsCurrent, msg.containerID)
+			m.cpuStatsMu.Unlock()
+
+			m.processingMu.Lock()
+			delete(m.processing, msg.containerID)
+			m.processingMu.Unlock()
+
+			m.selectedMu.Lock()
+			delete(m.selected, msg.containerID)
+			m.selectedMu.Unlock()
+		}
+
+		return m, m.waitForActiveEvents()
+
+	case cpuTickMsg:
 		// Refresh CPU stats every 5 seconds with safe copies
-		return m, tea.Batch(
-			fetchCPUStats(m.dockerClient, containersCopy, prevStatsCopy),
-			cpuTickCmd(),
-		)
+		// Multi-host: background hosts keep their history and stats cache up to date
+		cmds := []tea.Cmd{m.fetchHostCPUStats(m.activeHost), cpuTickCmd()}
+		for i := range m.hosts {
+			if i != m.activeHost {
+				cmds = append(cmds, m.fetchHostCPUStats(i))
+			}
+		}
+		return m, tea.Batch(cmds...)
 
 	case logRateTickMsg:
 		return m, logRateTickCmd()
@@ -333,30 +459,18 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Collect stale IDs from ALL maps (not just cpuPrevStats)
 		m.cpuStatsMu.Lock()
-		toDelete := make(map[string]bool)
+		m.activeStats().prune(currentIDs)
 
-		// Check all three maps for stale entries
-		for id := range m.cpuPrevStats {
-			if !currentIDs[id] {
-				toDelete[id] = true
-			}
-		}
-		for id := range m.cpuStats {
-			if !currentIDs[id] {
-				toDelete[id] = true
+		// Multi-host: stats of background hosts are cleaned with their EventWatcher list
+		for i, host := range m.hosts {
+			if i == m.activeHost || host.stats == nil {
+				continue
 			}
-		}
-		for id := range m.cpuCurrent {
-			if !currentIDs[id] {
-				toDelete[id] = true
+			hostIDs := make(map[string]bool)
+			for _, c := range host.eventWatcher.GetContainers() {
+				hostIDs[c.ID] = true
 			}
-		}
-
-		// Delete from all maps
-		for id := range toDelete {
-			delete(m.cpuStats, id)
-			delete(m.cpuCurrent, id)
-			delete(m.cpuPrevStats, id)
+			host.stats.prune(hostIDs)
 		}
 		m.cpuStatsMu.Unlock()
 
@@ -370,7 +484,7 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		m.processingMu.Unlock()
 		// Now trigger the actual action
-		return m, performActionAsync(m.dockerClient, msg.action, msg.ids, m.containers)
+		return m, performActionAsync(m.dockerClient, m.reloadContainers(), msg.action, msg.ids, m.containers)
 
 	case toastMsg:
 		// Clear processing state for containers (thread-safe)
@@ -405,6 +519,110 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return toastMsg{message: "", isError: false}
 		})
 
+	case execFinishedMsg:
+		// Back from an interactive shell: report failures, force a full redraw
+		if msg.err != nil {
+			return m, tea.Batch(tea.ClearScreen, func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("shell %s: %v", msg.containerName, msg.err), isError: true}
+			})
+		}
+		return m, tea.ClearScreen
+
+	case inspectMsg:
+		// Ignore late results for a container we are no longer inspecting
+		if m.view != inspectView || msg.containerID != m.inspectContainerID {
+			return m, nil
+		}
+		m.inspectLoading = false
+		m.inspectErr = msg.err
+		if msg.err == nil {
+			m.inspectSections = buildInspectSections(msg.data, msg.repoDigests)
+		}
+		m.moveInspectCursor(0)
+		return m, nil
+
+	case imageListMsg:
+		m.imagesLoading = false
+		if msg.err != nil {
+			return m, func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("images: %v", msg.err), isError: true}
+			}
+		}
+		m.imageRows = buildImageRows(msg.images)
+		if m.imagesCursor >= len(m.imageRows) {
+			m.imagesCursor = max(0, len(m.imageRows)-1)
+		}
+		// Drop selections of images that no longer exist
+		present := make(map[string]bool, len(m.imageRows))
+		for _, row := range m.imageRows {
+			present[row.ref()] = true
+		}
+		for ref := range m.imagesSelected {
+			if !present[ref] {
+				delete(m.imagesSelected, ref)
+			}
+		}
+		return m, nil
+
+	case volumeListMsg:
+		m.volumesLoading = false
+		if msg.err != nil {
+			return m, func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("volumes: %v", msg.err), isError: true}
+			}
+		}
+		m.volumeRows = buildVolumeRows(msg.volumes)
+		if m.volumesCursor >= len(m.volumeRows) {
+			m.volumesCursor = max(0, len(m.volumeRows)-1)
+		}
+		present := make(map[string]bool, len(m.volumeRows))
+		for _, row := range m.volumeRows {
+			present[row.name] = true
+		}
+		for name := range m.volumesSelected {
+			if !present[name] {
+				delete(m.volumesSelected, name)
+			}
+		}
+		return m, nil
+
+	case networkListMsg:
+		m.networksLoading = false
+		if msg.err != nil {
+			return m, func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("networks: %v", msg.err), isError: true}
+			}
+		}
+		m.networkRows = buildNetworkRows(msg.networks)
+		if m.networksCursor >= len(m.networkRows) {
+			m.networksCursor = max(0, len(m.networkRows)-1)
+		}
+		present := make(map[string]bool, len(m.networkRows))
+		for _, row := range m.networkRows {
+			present[row.id] = true
+		}
+		for id := range m.networksSelected {
+			if !present[id] {
+				delete(m.networksSelected, id)
+			}
+		}
+		return m, nil
+
+	case imagePullProgressMsg:
+		// Redraw happens automatically: keep listening while the pull runs
+		if m.imagePull != nil {
+			return m, waitForPullProgress(m.imagePull.updates)
+		}
+		return m, nil
+
+	case imagePullDoneMsg:
+		m.imagePull = nil
+		toast := pullDoneToast(msg)
+		return m, tea.Batch(
+			loadImages(m.dockerClient),
+			func() tea.Msg { return toast },
+		)
+
 	case errorMsg:
 		m.err = msg.err
 		return m, nil
@@ -431,18 +649,22 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // Filter helpers
 
 // compileFilter compiles the filter input as a regex (only for listView)
-// In logsView, regex is never used (always substring search)
+// In logsView, the filter language is parsed instead (see logfilter.go)
 func (m *model) compileFilter(input string) {
+	// Matches of the previous filter are gone
+	m.logsMatchKey = nil
+
 	if input == "" {
 		m.filterRegex = nil
 		m.filterIsRegex = false
 		return
 	}
 
-	// Skip regex compilation in logsView (not used for log filtering)
+	// Skip regex compilation in logsView (terms are parsed by activeLogFilter)
 	if m.view == logsView {
 		m.filterRegex = nil
 		m.filterIsRegex = false
+		m.activeLogFilter()
 		return
 	}
 
@@ -475,10 +697,19 @@ func (m *model) containerMatchesFilter(c types.Container) bool {
 
 // stripAnsiCodes removes ANSI escape sequences from a string
 func stripAnsiCodes(s string) string {
+	clean, _ := splitAnsiCodes(s)
+	return clean
+}
+
+// splitAnsiCodes removes ANSI escape sequences from a string and returns, for each byte
+// of the result, its offset in the original string (used to highlight text without
+// breaking escape sequences)
+func splitAnsiCodes(s string) (string, []int) {
 	// Match ANSI escape sequences: ESC [ ... m (and other variants)
 	// This regex matches: \x1b\[ followed by any characters until 'm'
 	// Also matches \x1b] and other escape sequences
 	var result strings.Builder
+	offsets := make([]int, 0, len(s))
 	inEscape := false
 	escapeStart := false
 
@@ -505,9 +736,10 @@ func stripAnsiCodes(s string) string {
 		}
 
 		result.WriteByte(s[i])
+		offsets = append(offsets, i)
 	}
 
-	return result.String()
+	return result.String(), offsets
 }
 
 // logLineMatchesFilter checks if a log line matches the active filter
@@ -516,12 +748,17 @@ func (m *model) logLineMatchesFilter(line string) bool {
 		return true
 	}
 
-	// Strip ANSI codes before searching to avoid false negatives/positives
-	cleanLine := stripAnsiCodes(line)
+	// Case-insensitive substring terms by default, regex terms when toggled
+	return m.activeLogFilter().Match(line)
+}
 
-	// ALWAYS use case-insensitive substring search for log filtering
-	// (regex matching can be confusing for users who just want to search text)
-	return strings.Contains(strings.ToLower(cleanLine), strings.ToLower(m.filterActive))
+// logsViewLineMatchesFilter checks if a logs view line matches the active filter (parsed fields
+// are reused for field queries)
+func (m *model) logsViewLineMatchesFilter(line logsViewLine) bool {
+	if m.filterActive == "" {
+		return true
+	}
+	return m.activeLogFilter().MatchFields(line.text, line.fields)
 }
 
 // updateWasAtBottom updates the wasAtBottom and logsViewPaused flags based on scroll position
@@ -543,28 +780,17 @@ func (m *model) updateWasAtBottom() {
 
 // getFilteredLogCount returns the number of logs after filtering
 func (m *model) getFilteredLogCount() int {
-	var rawLogs []string
-
-	if m.bufferConsumer != nil {
-		entries := m.bufferConsumer.GetBuffer()
-		rawLogs = make([]string, len(entries))
-		for i, entry := range entries {
-			displayName := m.cleanContainerName(entry.ContainerName)
-			rawLogs[i] = fmt.Sprintf("[%s] %s", displayName, entry.Line)
-		}
-	} else {
-		rawLogs = m.logsViewBuffer
-	}
+	rawLogs := m.logsViewLines()
 
-	// If no filter, return raw count
-	if m.filterActive == "" {
+	// If no filter (or highlight mode: all lines stay visible), return raw count
+	if m.filterActive == "" || m.logsHighlightMode {
 		return len(rawLogs)
 	}
 
 	// Count filtered logs
 	count := 0
 	for _, line := range rawLogs {
-		if m.logLineMatchesFilter(line) {
+		if m.logsViewLineMatchesFilter(line) {
 			count++
 		}
 	}
@@ -580,6 +806,14 @@ func (m *model) View() string {
 		return m.renderLogs()
 	case mcpLogsView:
 		return m.renderMCPLogs()
+	case inspectView:
+		return m.renderInspect()
+	case imagesView:
+		return m.renderImages()
+	case volumesView:
+		return m.renderVolumes()
+	case networksView:
+		return m.renderNetworks()
 	default:
 		return m.renderList()
 	}
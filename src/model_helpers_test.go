@@ -4,6 +4,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/docker/docker/api/types"
 )
@@ -125,7 +126,7 @@ func TestBufferConsumer_InsertSeparator(t *testing.T) {
 	}
 }
 
-func TestBufferConsumer_PreloadLogs(t *testing.T) {
+func TestBufferConsumer_PreloadEntries(t *testing.T) {
 	closing := atomic.Bool{}
 	wg := sync.WaitGroup{}
 
@@ -137,9 +138,10 @@ func TestBufferConsumer_PreloadLogs(t *testing.T) {
 		&wg,
 	)
 
-	recentLogs := map[string][]string{
-		"c1": {"log1", "log2"},
-		"c2": {"log3"},
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 0, time.UTC)
+	recentLogs := map[string][]LogEntry{
+		"c1": {{Line: "log1", Timestamp: ts}, {Line: "log2", Timestamp: ts.Add(2 * time.Second)}},
+		"c2": {{Line: "log3", Timestamp: ts.Add(time.Second)}},
 	}
 
 	containerNames := map[string]string{
@@ -147,7 +149,7 @@ func TestBufferConsumer_PreloadLogs(t *testing.T) {
 		"c2": "container2",
 	}
 
-	bc.PreloadLogs([]string{"c1", "c2"}, recentLogs, containerNames)
+	bc.PreloadEntries([]string{"c1", "c2"}, recentLogs, containerNames)
 
 	if bc.size != 3 {
 		t.Errorf("Expected size=3 after preload, got %d", bc.size)
@@ -155,7 +157,11 @@ func TestBufferConsumer_PreloadLogs(t *testing.T) {
 
 	buffer := bc.GetBuffer()
 	if len(buffer) != 3 {
-		t.Errorf("Expected 3 entries in buffer, got %d", len(buffer))
+		t.Fatalf("Expected 3 entries in buffer, got %d", len(buffer))
+	}
+	// Merged by time, with the container name of each entry
+	if buffer[1].Line != "log3" || buffer[1].ContainerName != "container2" {
+		t.Errorf("Expected log3 of container2 in the middle, got %+v", buffer[1])
 	}
 }
 
@@ -171,6 +171,38 @@ func TestUpdate_CPUStatsMsg_HistoryLimit(t *testing.T) {
 	}
 }
 
+func TestUpdate_CPUStatsMsg_ContainerStats(t *testing.T) {
+	cache := NewStatsCache()
+	m := &model{
+		cpuStats:     make(map[string][]float64),
+		cpuCurrent:   make(map[string]float64),
+		cpuPrevStats: make(map[string]*container.StatsResponse),
+		statsCache:   cache,
+	}
+
+	msg := cpuStatsMsg{
+		stats:          map[string]float64{"c1": 10.0},
+		rawStats:       map[string]*container.StatsResponse{"c1": {}},
+		containerStats: map[string]ContainerStats{"c1": {MemUsage: 1024, PIDs: 3}},
+	}
+
+	newModel, _ := m.Update(msg)
+	m = newModel.(*model)
+
+	if m.statsCurrent["c1"].MemUsage != 1024 {
+		t.Errorf("Expected statsCurrent[c1].MemUsage=1024, got %d", m.statsCurrent["c1"].MemUsage)
+	}
+	if history := cache.GetHistory("c1"); len(history) != 1 {
+		t.Errorf("Expected 1 cached stats history entry, got %d", len(history))
+	}
+
+	// Cache mirrors CPU and resource stats
+	cached, ok := cache.GetForContainer("c1")
+	if !ok || cached.CPUPercent != 10.0 || cached.PIDs != 3 {
+		t.Errorf("Expected cached stats with CPU=10 and PIDs=3, got %+v (ok=%v)", cached, ok)
+	}
+}
+
 func TestUpdate_TickMsg(t *testing.T) {
 	m := &model{
 		spinnerFrame: 0,
@@ -315,7 +347,7 @@ func TestUpdate_NewLogLineMsg_AtBottom(t *testing.T) {
 
 	// Add some logs
 	for i := 0; i < 50; i++ {
-		m.bufferConsumer.OnLogLine("c1", "container1", "log line", time.Now())
+		m.bufferConsumer.OnLogLine("c1", "container1", "log line", streamStdout, time.Now())
 	}
 
 	msg := newLogLineMsg{}
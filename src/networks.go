@@ -0,0 +1,207 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
+)
+
+// networkRow is one line of the networks view
+type networkRow struct {
+	id     string
+	name   string
+	driver string
+	scope  string
+	subnet string // IPAM subnets, comma separated
+}
+
+// shortID returns the 12-character network ID, like `docker network ls`
+func (r networkRow) shortID() string {
+	return r.id[:min(12, len(r.id))]
+}
+
+// networkListMsg carries the result of NetworkList
+type networkListMsg struct {
+	networks []network.Summary
+	err      error
+}
+
+// attachedContainer is a container found on a network by cross-referencing the container list
+type attachedContainer struct {
+	id   string
+	name string
+}
+
+// loadNetworks lists networks
+func loadNetworks(cli *client.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		networks, err := cli.NetworkList(ctx, network.ListOptions{})
+		return networkListMsg{networks: networks, err: err}
+	}
+}
+
+// buildNetworkRows converts networks into rows sorted by name
+func buildNetworkRows(networks []network.Summary) []networkRow {
+	rows := make([]networkRow, 0, len(networks))
+	for _, n := range networks {
+		subnets := []string{}
+		for _, cfg := range n.IPAM.Config {
+			if cfg.Subnet != "" {
+				subnets = append(subnets, cfg.Subnet)
+			}
+		}
+		rows = append(rows, networkRow{
+			id:     n.ID,
+			name:   n.Name,
+			driver: n.Driver,
+			scope:  n.Scope,
+			subnet: strings.Join(subnets, ","),
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].name < rows[j].name })
+	return rows
+}
+
+// networkContainers returns the containers attached to each network, keyed by network ID
+// (or by network name when the endpoint has no ID, e.g. for some stopped containers)
+func (m *model) networkContainers() map[string][]attachedContainer {
+	attached := make(map[string][]attachedContainer)
+	m.containersMu.RLock()
+	for _, c := range m.containers {
+		if c.NetworkSettings == nil {
+			continue
+		}
+		for name, ep := range c.NetworkSettings.Networks {
+			key := name
+			if ep != nil && ep.NetworkID != "" {
+				key = ep.NetworkID
+			}
+			attached[key] = append(attached[key], attachedContainer{id: c.ID, name: m.cleanContainerName(getContainerName(c))})
+		}
+	}
+	m.containersMu.RUnlock()
+
+	for key := range attached {
+		sort.Slice(attached[key], func(i, j int) bool { return attached[key][i].name < attached[key][j].name })
+	}
+	return attached
+}
+
+// containers returns the containers attached to the network, from the networkContainers map
+func (r networkRow) containers(attached map[string][]attachedContainer) []attachedContainer {
+	return append(append([]attachedContainer{}, attached[r.id]...), attached[r.name]...)
+}
+
+// selectedNetworkIDs returns the selected network IDs in display order, or the cursor row
+func (m *model) selectedNetworkIDs() []string {
+	ids := []string{}
+	for _, row := range m.networkRows {
+		if m.networksSelected[row.id] {
+			ids = append(ids, row.id)
+		}
+	}
+	if len(ids) == 0 && m.networksCursor >= 0 && m.networksCursor < len(m.networkRows) {
+		ids = append(ids, m.networkRows[m.networksCursor].id)
+	}
+	return ids
+}
+
+// networkName returns the name of a listed network, or its short ID
+func (m *model) networkName(id string) string {
+	for _, row := range m.networkRows {
+		if row.id == id {
+			return row.name
+		}
+	}
+	return id[:min(12, len(id))]
+}
+
+// removeNetworks removes the given networks
+func removeNetworks(cli *client.Client, ids []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		var errors []string
+		successCount := 0
+		for _, id := range ids {
+			if err := cli.NetworkRemove(ctx, id); err != nil {
+				errors = append(errors, fmt.Sprintf("%s: %v", id[:min(12, len(id))], err))
+				continue
+			}
+			successCount++
+		}
+
+		return tea.Batch(
+			loadNetworks(cli),
+			func() tea.Msg { return resultToast("remove", "network", successCount, errors) },
+		)()
+	}
+}
+
+// pruneNetworks removes all custom networks not used by any container
+func pruneNetworks(cli *client.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		report, err := cli.NetworksPrune(ctx, filters.NewArgs())
+		if err != nil {
+			return toastMsg{message: fmt.Sprintf("prune failed: %v", err), isError: true}
+		}
+
+		return tea.Batch(
+			loadNetworks(cli),
+			func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("prune: %d network(s) deleted", len(report.NetworksDeleted))}
+			},
+		)()
+	}
+}
+
+// connectNetwork connects (or disconnects) containers to/from a network, then reloads
+// the container list with reload (see reloadContainers) so the attachments shown in the
+// networks view are up to date
+func connectNetwork(cli *client.Client, reload tea.Cmd, networkID string, containerIDs []string, connect bool) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		action := "connect"
+		if !connect {
+			action = "disconnect"
+		}
+
+		var errors []string
+		successCount := 0
+		for _, id := range containerIDs {
+			var err error
+			if connect {
+				err = cli.NetworkConnect(ctx, networkID, id, nil)
+			} else {
+				err = cli.NetworkDisconnect(ctx, networkID, id, false)
+			}
+			if err != nil {
+				errors = append(errors, fmt.Sprintf("%s: %v", id[:min(12, len(id))], err))
+				continue
+			}
+			successCount++
+		}
+
+		return tea.Batch(
+			reload,
+			loadNetworks(cli),
+			func() tea.Msg { return resultToast(action, "container", successCount, errors) },
+		)()
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+)
+
+func sampleNetworkModel() *model {
+	m := createTestModel()
+	m.width = 160
+	m.containers = []types.Container{
+		{ID: "c1", Names: []string{"/api"}, NetworkSettings: &container.NetworkSettingsSummary{
+			Networks: map[string]*network.EndpointSettings{"backend": {NetworkID: "net1"}},
+		}},
+		{ID: "c2", Names: []string{"/db"}, NetworkSettings: &container.NetworkSettingsSummary{
+			Networks: map[string]*network.EndpointSettings{"backend": {NetworkID: "net1"}, "bridge": {}},
+		}},
+		{ID: "c3", Names: []string{"/web"}},
+	}
+	m.networksSelected = make(map[string]bool)
+	m.networkRows = buildNetworkRows([]network.Summary{
+		{ID: "net1", Name: "backend", Driver: "bridge", Scope: "local", IPAM: network.IPAM{Config: []network.IPAMConfig{{Subnet: "172.20.0.0/16"}}}},
+		{ID: "net0", Name: "bridge", Driver: "bridge", Scope: "local"},
+	})
+	m.view = networksView
+	return m
+}
+
+func TestBuildNetworkRows(t *testing.T) {
+	m := sampleNetworkModel()
+	if len(m.networkRows) != 2 || m.networkRows[0].name != "backend" {
+		t.Fatalf("unexpected rows %+v", m.networkRows)
+	}
+	if m.networkRows[0].subnet != "172.20.0.0/16" {
+		t.Errorf("subnet = %q", m.networkRows[0].subnet)
+	}
+}
+
+func TestNetworkContainers(t *testing.T) {
+	m := sampleNetworkModel()
+	attached := m.networkContainers()
+
+	names := []string{}
+	for _, c := range m.networkRows[0].containers(attached) {
+		names = append(names, c.name)
+	}
+	if strings.Join(names, ",") != "api,db" {
+		t.Errorf("backend containers = %v", names)
+	}
+
+	// Endpoints without NetworkID are matched by network name
+	if members := m.networkRows[1].containers(attached); len(members) != 1 || members[0].id != "c2" {
+		t.Errorf("bridge containers = %v", members)
+	}
+
+	output := m.View()
+	if !strings.Contains(output, "api, db") || !strings.Contains(output, "172.20.0.0/16") {
+		t.Error("networks view should show subnet and attached containers")
+	}
+}
+
+func TestNetworkConnectConfirmation(t *testing.T) {
+	m := sampleNetworkModel()
+
+	// Nothing selected and cursor container (api) already on backend
+	_, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if cmd == nil || m.view != networksView {
+		t.Fatal("connecting an attached container should only toast")
+	}
+	if toast, ok := cmd().(toastMsg); !ok || !toast.isError || !strings.Contains(toast.message, "already connected") {
+		t.Errorf("unexpected toast %#v", toast)
+	}
+
+	m.selected = map[string]bool{"c2": true, "c3": true}
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	if m.view != confirmView || m.pendingAction != "connect-network" || m.pendingNetworkID != "net1" {
+		t.Fatalf("c should ask for confirmation, got view %v action %q", m.view, m.pendingAction)
+	}
+	if len(m.pendingTargets) != 1 || m.pendingTargets[0] != "c3" {
+		t.Errorf("only unattached containers should be connected, got %v", m.pendingTargets)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'n'}})
+	if m.view != networksView || m.pendingNetworkID != "" {
+		t.Error("cancel should return to the networks view and forget the network")
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	if m.pendingAction != "disconnect-network" || len(m.pendingTargets) != 1 || m.pendingTargets[0] != "c2" {
+		t.Errorf("u should disconnect attached containers only, got %q %v", m.pendingAction, m.pendingTargets)
+	}
+}
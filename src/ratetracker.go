@@ -1,6 +1,7 @@
 package main
 
 import (
+	"regexp"
 	"sync"
 	"time"
 )
@@ -89,19 +90,36 @@ func (lrt *LogRateTracker) GetRate() float64 {
 
 // RateTrackerConsumer implements LogConsumer to track log rates
 type RateTrackerConsumer struct {
-	rates   map[string]*LogRateTracker
-	ratesMu sync.RWMutex
+	rates         map[string]*LogRateTracker
+	errors        map[string]*errorRateTracker // Error lines over 5 minutes (see isErrorLine)
+	errorPatterns []*regexp.Regexp             // Extra error patterns (--error-pattern)
+	ratesMu       sync.RWMutex
 }
 
 // NewRateTrackerConsumer creates a new instance
 func NewRateTrackerConsumer() *RateTrackerConsumer {
 	return &RateTrackerConsumer{
-		rates: make(map[string]*LogRateTracker),
+		rates:  make(map[string]*LogRateTracker),
+		errors: make(map[string]*errorRateTracker),
 	}
 }
 
+// SetErrorPatterns sets the patterns counted as errors in addition to ERROR/FATAL lines
+func (rtc *RateTrackerConsumer) SetErrorPatterns(patterns []*regexp.Regexp) {
+	rtc.ratesMu.Lock()
+	defer rtc.ratesMu.Unlock()
+	rtc.errorPatterns = patterns
+}
+
 // OnLogLine is called when a new log line arrives
-func (rtc *RateTrackerConsumer) OnLogLine(containerID, containerName, line string, timestamp time.Time) {
+func (rtc *RateTrackerConsumer) OnLogLine(containerID, containerName, line, stream string, timestamp time.Time) {
+	// Classify before taking the write lock: it is shared by every container
+	// Text patterns only: parsing JSON for every line of every container is too costly here
+	rtc.ratesMu.RLock()
+	patterns := rtc.errorPatterns
+	rtc.ratesMu.RUnlock()
+	isError := isErrorLine(line, patterns)
+
 	rtc.ratesMu.Lock()
 	defer rtc.ratesMu.Unlock()
 
@@ -113,6 +131,14 @@ func (rtc *RateTrackerConsumer) OnLogLine(containerID, containerName, line strin
 	}
 
 	rtc.rates[containerID].AddLine()
+
+	// Bucketed at the Docker time: replayed tails don't count as current errors
+	if isError {
+		if rtc.errors[containerID] == nil {
+			rtc.errors[containerID] = &errorRateTracker{}
+		}
+		rtc.errors[containerID].add(timestamp, time.Now())
+	}
 }
 
 // OnContainerStatusChange is called when a container changes state
@@ -120,6 +146,7 @@ func (rtc *RateTrackerConsumer) OnContainerStatusChange(containerID string, isRu
 	if !isRunning {
 		rtc.ratesMu.Lock()
 		delete(rtc.rates, containerID)
+		delete(rtc.errors, containerID)
 		rtc.ratesMu.Unlock()
 	}
 }
@@ -136,6 +163,23 @@ func (rtc *RateTrackerConsumer) GetRate(containerID string) float64 {
 	return tracker.GetRate()
 }
 
+// GetErrorRate returns the number of error lines of a container in the last minute
+func (rtc *RateTrackerConsumer) GetErrorRate(containerID string) float64 {
+	return rtc.GetErrorStats(containerID).PerMinute1m
+}
+
+// GetErrorStats returns the 1m/5m error rates and the 5-minute history of a container
+func (rtc *RateTrackerConsumer) GetErrorStats(containerID string) ErrorStats {
+	rtc.ratesMu.RLock()
+	defer rtc.ratesMu.RUnlock()
+
+	tracker := rtc.errors[containerID]
+	if tracker == nil {
+		return ErrorStats{History: make([]int, errorSparklineWidth)}
+	}
+	return tracker.stats(time.Now())
+}
+
 // CleanupStaleContainers removes entries for containers that haven't logged in >5 minutes
 // This prevents memory leak when OnContainerStatusChange is not called
 func (rtc *RateTrackerConsumer) CleanupStaleContainers() {
@@ -180,6 +224,7 @@ func (rtc *RateTrackerConsumer) CleanupStaleContainers() {
 		rtc.ratesMu.Lock()
 		for _, containerID := range staleIDs {
 			delete(rtc.rates, containerID)
+			delete(rtc.errors, containerID)
 		}
 		rtc.ratesMu.Unlock()
 	}
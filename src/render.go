@@ -5,6 +5,7 @@ import (
 	"hash/fnv"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/docker/docker/api/types"
@@ -37,9 +38,20 @@ func getContainerLogColor(containerName string) lipgloss.Color {
 func (m *model) renderFilterBar() string {
 	// Build filter text with cursor
 	filterText := "Filter: " + m.filterInput + "█"
+	invalid := m.filterInput != "" && !m.filterIsRegex
+
+	// Logs view: filter language, only invalid regex terms are highlighted
+	if m.view == logsView {
+		label := "Filter"
+		if m.logsFilterRegex {
+			label = "Filter (regex)"
+		}
+		filterText = label + ": " + m.filterInput + "█  [Ctrl+R] Regex  -term: exclude  a | b: OR  container:name"
+		invalid = m.activeLogFilter().err != nil
+	}
 
 	// Apply red background if regex is invalid
-	if m.filterInput != "" && !m.filterIsRegex {
+	if invalid {
 		invalidStyle := lipgloss.NewStyle().Background(lipgloss.Color("#3d1a1a")).Foreground(lipgloss.Color("#ffffff"))
 		return invalidStyle.Render(filterText)
 	}
@@ -140,8 +152,8 @@ func (m *model) renderLogs() string {
 
 	// Calculate reserved lines at bottom
 	bottomLines := 4 // separator + help bar + toast + blank line before separator
-	if m.filterMode {
-		bottomLines++ // filter bar
+	if m.filterMode || m.logsExportMode {
+		bottomLines++ // filter bar or export prompt
 	}
 
 	// Title takes 2 lines
@@ -153,32 +165,33 @@ func (m *model) renderLogs() string {
 		availableLines = 1
 	}
 
-	sb.WriteString(titleStyle.Render("📋 Container Logs") + "\n\n")
+	title := "📋 Container Logs"
+	if m.viewerFiles != nil {
+		title = truncateText("📂 "+strings.Join(m.viewerFiles, ", "), max(20, m.width-4))
+	}
+	sb.WriteString(titleStyle.Render(title) + "\n\n")
 
 	// Get logs from BufferConsumer and format them
-	var rawLogs []string
-	if m.bufferConsumer != nil {
-		entries := m.bufferConsumer.GetBuffer()
-		rawLogs = make([]string, len(entries))
-		for i, entry := range entries {
-			if entry.IsSeparator {
-				// Use special marker for separators to identify them during rendering
-				rawLogs[i] = "[SEPARATOR] " + entry.Line
-			} else {
-				displayName := m.cleanContainerName(entry.ContainerName)
-				rawLogs[i] = fmt.Sprintf("[%s] %s", displayName, entry.Line)
-			}
-		}
-	} else {
-		rawLogs = m.logsViewBuffer // Fallback si pas de consumer
+	rawLogs := m.logsViewLines()
+
+	// Highlight mode: every line stays visible, matches are colored
+	highlighting := m.logsHighlightMode && m.filterActive != ""
+	var logFilter *logFilter
+	var matches []int
+	if highlighting {
+		logFilter = m.activeLogFilter()
+		matches = m.logMatchLines(rawLogs)
 	}
 
+	// Current match of n/N, anchored to its entry
+	matchLine := m.logsMatchIndex(rawLogs)
+
 	// Filter logs if filter is active
 	filteredLogs := rawLogs
-	if m.filterActive != "" {
-		filteredLogs = []string{}
+	if m.filterActive != "" && !highlighting {
+		filteredLogs = []logsViewLine{}
 		for _, line := range rawLogs {
-			if m.logLineMatchesFilter(line) {
+			if m.logsViewLineMatchesFilter(line) {
 				filteredLogs = append(filteredLogs, line)
 			}
 		}
@@ -195,9 +208,10 @@ func (m *model) renderLogs() string {
 	end := min(start+visibleLines, len(filteredLogs))
 
 	// Display logs in the visible window with container-specific background colors
+	now := time.Now()
 	linesRendered := 0
 	for i := start; i < end; i++ {
-		logLine := filteredLogs[i]
+		logLine := filteredLogs[i].text
 
 		// Check if this is a separator line (just render as blank line)
 		if strings.HasPrefix(logLine, "[SEPARATOR] ") {
@@ -206,6 +220,18 @@ func (m *model) renderLogs() string {
 			continue
 		}
 
+		// Timestamp column (Docker time of the line)
+		if m.logsTimestampMode != timestampOff {
+			var prev time.Time
+			for j := i - 1; j >= 0; j-- {
+				if !strings.HasPrefix(filteredLogs[j].text, "[SEPARATOR] ") {
+					prev = filteredLogs[j].timestamp
+					break
+				}
+			}
+			sb.WriteString(logTimestampStyle.Render(formatLogTimestamp(m.logsTimestampMode, filteredLogs[i].timestamp, prev, now)) + " ")
+		}
+
 		// Parse container name from log line format: [containerName] logContent
 		if strings.HasPrefix(logLine, "[") {
 			endBracket := strings.Index(logLine, "]")
@@ -213,9 +239,26 @@ func (m *model) renderLogs() string {
 				containerName := logLine[1:endBracket]
 				logContent := logLine[endBracket+1:]
 
+				// Pretty mode: structured lines as level/time/msg columns, other fields collapsed
+				if m.logsPrettyMode && filteredLogs[i].fields != nil {
+					logContent = " " + formatPrettyLogLine(filteredLogs[i].fields, m.logsPrettyWidth())
+				}
+
+				// Current match of n/N stands out from the other matches
+				hlStyle := logHighlightStyle
+				if i == matchLine {
+					hlStyle = logCurrentHighlightStyle
+				}
+
 				// Clean container name in demo mode
 				displayName := m.cleanContainerName(containerName)
 
+				// stderr lines get a heavier, red separator
+				separator := logSeparatorFor(filteredLogs[i].stream)
+
+				// Content foreground by level (INFO and unknown keep the default)
+				levelColor, hasLevelColor := logLevelColors[filteredLogs[i].level]
+
 				// Apply container-specific background color only if enabled
 				var containerPart, contentPart string
 				if m.logsColorEnabled {
@@ -230,16 +273,37 @@ func (m *model) renderLogs() string {
 					}
 
 					// Format: colored container name (padded) + pipe separator + log content with same background
-					containerPart = style.Render(paddedName + " │")
-					contentPart = style.Render(logContent)
+					if separator == logStderrMarker {
+						containerPart = style.Render(paddedName+" ") + logStderrMarkerStyle.Background(bgColor).Render(separator)
+					} else {
+						containerPart = style.Render(paddedName + " " + separator)
+					}
+					contentStyle := style
+					if hasLevelColor {
+						contentStyle = style.Foreground(levelColor)
+					}
+					if highlighting {
+						contentPart = highlightLogContent(logContent, logFilter, &contentStyle, hlStyle)
+					} else {
+						contentPart = contentStyle.Render(logContent)
+					}
 				} else {
 					// No colors: simple text with separator
 					paddedName := displayName
 					if m.logsViewMaxNameWidth > 0 && len(displayName) < m.logsViewMaxNameWidth {
 						paddedName = displayName + strings.Repeat(" ", m.logsViewMaxNameWidth-len(displayName))
 					}
-					containerPart = paddedName + " │"
+					containerPart = paddedName + " " + separator
 					contentPart = logContent
+					var contentStyle *lipgloss.Style
+					if hasLevelColor {
+						levelStyle := lipgloss.NewStyle().Foreground(levelColor)
+						contentStyle = &levelStyle
+						contentPart = levelStyle.Render(logContent)
+					}
+					if highlighting {
+						contentPart = highlightLogContent(logContent, logFilter, contentStyle, hlStyle)
+					}
 				}
 
 				sb.WriteString(containerPart + contentPart + "\n")
@@ -260,7 +324,14 @@ func (m *model) renderLogs() string {
 	}
 
 	// Build help bar (left-aligned)
-	helpText := "[Q/ESC] Back  [ENTER] Insert Mark  [C] Toggle Colors  [↑/↓/PgUp/PgDn/Home/End/Wheel] Scroll  [/] Filter"
+	back := "[Q/ESC] Back"
+	if m.viewerFiles != nil {
+		back = "[Q/ESC] Quit"
+	}
+	helpText := back + "  [ENTER] Insert Mark  [C] Toggle Colors  [T] Time  [E] Stderr  [P] Pretty  [L] Level  [S] Save  [↑/↓/PgUp/PgDn/Home/End/Wheel] Scroll  [/] Filter  [R] Regex  [H] Highlight"
+	if highlighting {
+		helpText += "  [n/N] Next/Prev"
+	}
 
 	// Build scroll indicator (right-aligned)
 	scrollInfo := ""
@@ -282,11 +353,32 @@ func (m *model) renderLogs() string {
 	}
 
 	// Show filter indicator if active
-	if m.filterActive != "" {
+	if highlighting {
+		current := "-"
+		if pos := logMatchPosition(matches, matchLine); pos > 0 {
+			current = fmt.Sprintf("%d", pos)
+		}
+		scrollInfo = fmt.Sprintf("🔦 match %s/%d %s", current, len(matches), scrollInfo)
+	} else if m.filterActive != "" {
 		totalLines := len(rawLogs)
 		filteredCount := len(filteredLogs)
 		scrollInfo = fmt.Sprintf("🔍 %d/%d %s", filteredCount, totalLines, scrollInfo)
 	}
+	if m.logsFilterRegex {
+		scrollInfo = "[regex] " + scrollInfo
+	}
+	if label := m.logsTimestampMode.label(); label != "" {
+		scrollInfo = "[" + label + "] " + scrollInfo
+	}
+	if m.logsStderrOnly {
+		scrollInfo = "[stderr] " + scrollInfo
+	}
+	if m.logsPrettyMode {
+		scrollInfo = "[pretty] " + scrollInfo
+	}
+	if m.logsMinLevel != levelUnknown {
+		scrollInfo = "[≥" + m.logsMinLevel.String() + "] " + scrollInfo
+	}
 
 	// Add debug metrics if debug monitoring is enabled
 	if m.debugMonitor {
@@ -325,11 +417,114 @@ func (m *model) renderLogs() string {
 	// Show filter bar if in filter mode
 	if m.filterMode {
 		sb.WriteString("\n" + m.renderFilterBar())
+	} else if m.logsExportMode {
+		sb.WriteString("\n" + fmt.Sprintf("Save as (%s): %s█  [TAB] Format: plain / ansi / jsonl  [ENTER] Save  [ESC] Cancel", m.logsExportFormat, m.logsExportInput))
 	}
 
 	return sb.String()
 }
 
+func (m *model) renderInspect() string {
+	var sb strings.Builder
+
+	visibleLines := m.inspectVisibleLines()
+	lineWidth := max(80, m.width)
+
+	sb.WriteString(titleStyle.Render(fmt.Sprintf("🔍 Inspect: %s", m.cleanContainerName(m.inspectContainerName))) + "\n\n")
+
+	rows := m.inspectRows()
+	linesRendered := 0
+
+	switch {
+	case m.inspectErr != nil:
+		sb.WriteString(errorStyle.Render(fmt.Sprintf("Error: %v", m.inspectErr)) + "\n")
+		linesRendered++
+	case m.inspectLoading && len(rows) == 0:
+		sb.WriteString(processingStyle.Render("Loading...") + "\n")
+		linesRendered++
+	}
+
+	if m.inspectErr == nil {
+		start := m.inspectScroll
+		end := min(start+visibleLines-linesRendered, len(rows))
+		for i := start; i < end; i++ {
+			row := rows[i]
+			text := row.text
+			// Long values (env vars, commands) are cut rather than wrapped to keep the layout stable
+			if runes := []rune(text); len(runes) > lineWidth-1 {
+				text = string(runes[:lineWidth-2]) + "…"
+			}
+
+			switch {
+			case i == m.inspectCursor:
+				text = selectedLineStyle.Render(text + strings.Repeat(" ", max(0, lineWidth-1-lipgloss.Width(text))))
+			case row.header:
+				text = selectedStyle.Render(text)
+			}
+			sb.WriteString(text + "\n")
+			linesRendered++
+		}
+	}
+
+	// Fill remaining lines to push bottom bars down
+	for linesRendered < visibleLines {
+		sb.WriteString("\n")
+		linesRendered++
+	}
+
+	helpText := "[Q/ESC] Back  [ENTER/SPACE] Collapse/Expand  [+/-] Expand/Collapse All  [R] Refresh  [↑/↓/PgUp/PgDn/Home/End/Wheel] Move"
+
+	// Position indicator (right-aligned)
+	scrollInfo := ""
+	if m.inspectLoading && len(rows) > 0 {
+		scrollInfo = "[refreshing]"
+	} else if len(rows) > visibleLines {
+		scrollInfo = fmt.Sprintf("[%d/%d]", m.inspectCursor+1, len(rows))
+	}
+
+	separatorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#3c3c3c"))
+	sb.WriteString("\n" + separatorStyle.Render(strings.Repeat("─", lineWidth)))
+
+	spacing := lineWidth - lipgloss.Width(helpText) - lipgloss.Width(scrollInfo) - 2
+	if spacing < 2 {
+		spacing = 2
+	}
+	sb.WriteString("\n" + helpText + strings.Repeat(" ", spacing) + scrollInfo)
+
+	// Toast messages (fixed space to prevent UI jumping)
+	sb.WriteString("\n")
+	if m.toastMessage != "" {
+		if m.toastIsError {
+			sb.WriteString(toastErrorStyle.Render("✗ " + m.toastMessage))
+		} else {
+			sb.WriteString(toastSuccessStyle.Render("✓ " + m.toastMessage))
+		}
+	} else {
+		sb.WriteString(" ") // Reserve space even when no toast
+	}
+
+	return sb.String()
+}
+
+// highlightCursorLine pads a row to full width and applies the cursor background to it
+func highlightCursorLine(lineText string, width int) string {
+	// For cursor line, we need to inject background color into every ANSI sequence
+	// Replace all ANSI reset codes and background codes with our background
+
+	// First, pad to full width
+	visualWidth := lipgloss.Width(lineText)
+	if visualWidth < width {
+		lineText = lineText + strings.Repeat(" ", width-visualWidth)
+	}
+
+	// Inject our background color after every SGR reset or at the start
+	// Pattern: replace \x1b[0m with \x1b[0m\x1b[48;2;38;79;120m to maintain background
+	// Also add background at the very start
+	return "\x1b[48;2;38;79;120m" +
+		strings.ReplaceAll(lineText, "\x1b[0m", "\x1b[0m\x1b[48;2;38;79;120m") +
+		"\x1b[49m"
+}
+
 func (m *model) renderList() string {
 	var sb strings.Builder
 
@@ -340,12 +535,15 @@ func (m *model) renderList() string {
 
 	// Calculate reserved lines at bottom
 	// Help bar text (used later for rendering)
-	selectionHelp := "[SPACE] Select  [A] All  [Ctrl+A] Running  [X] Clear  [I] Invert"
-	actionsHelp := "[ENTER/L] Logs  [S] Start  [K] Kill (Stop)  [R] Restart  [P] Pause  [D] Remove  [/] Filter"
+	selectionHelp := "[SPACE] Select  [A] All  [Ctrl+A] Running  [X] Clear  [I] Invert  [G] Group"
+	if len(m.hosts) > 1 {
+		selectionHelp += "  [H] Host"
+	}
+	actionsHelp := "[ENTER/L] Logs  [V] Inspect  [E] Shell  [S] Start  [K] Kill (Stop)  [R] Restart  [P] Pause  [D] Remove  [/] Filter"
 	if m.mcpServer != nil {
 		actionsHelp += "  [M] MCP Logs"
 	}
-	actionsHelp += "  [Q/ESC] Quit"
+	actionsHelp += "  [TAB] Images  [Q/ESC] Quit"
 
 	// Fixed bottom lines: blank line + toast + blank line + help bar = 4 lines
 	bottomLines := 4
@@ -413,11 +611,27 @@ func (m *model) renderList() string {
 	stats := fmt.Sprintf("Total: %d │ Running: %d │ Stopped: %d",
 		totalContainers, runningContainers, stoppedContainers)
 
+	// Add active host in multi-host mode
+	if hostName := m.activeHostName(); hostName != "" {
+		stats = fmt.Sprintf("Host: %s (%d/%d) │ ", hostName, m.activeHost+1, len(m.hosts)) + stats
+	}
+
 	// Add hidden count if filter is active
 	if m.filterActive != "" {
 		stats += fmt.Sprintf(" │ Hidden: %d", hiddenCount)
 	}
 
+	// Add compose project count in grouped mode
+	if m.groupByProject {
+		projects := make(map[string]bool)
+		for _, c := range containersCopy {
+			if project := composeProject(c); project != "" {
+				projects[project] = true
+			}
+		}
+		stats += fmt.Sprintf(" │ Projects: %d", len(projects))
+	}
+
 	// Add MCP status if MCP server is running
 	// CRITICAL FIX: Copy pointer to avoid TOCTOU race with nil dereference
 	mcpSrv := m.mcpServer
@@ -453,24 +667,35 @@ func (m *model) renderList() string {
 	contentWidth := max(80, m.width) - 6 // -6 for border + padding
 
 	// Column headers - selection(2) = 2 chars prefix
-	// Widths: NAME(35) STATE(13) CPU(7) L/S(6) UPTIME(7) PORTS(variable)
+	// Widths: NAME(35) STATE(13) CPU(7) MEM(7) [NET(12) BLOCK(12) PIDS(4)] L/S(6) ERR/MIN(16) UPTIME(7) PORTS(variable)
 	// Format: column + space + sep + space (except last column)
+	// I/O columns are only shown when the terminal is wide enough to keep PORTS readable
+	showIOColumns := contentWidth >= 150
 	// Dark gray separator style
 	sepStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(colorSeparator))
 	sep := sepStyle.Render("│")
-	containerList.WriteString(fmt.Sprintf("  %-35s %s %-13s %s %-7s %s %-6s %s %-7s %s %s\n",
-		"NAME", sep, "STATE", sep, "CPU", sep, "L/S", sep, "UPTIME", sep, "PORTS"))
+	containerList.WriteString(fmt.Sprintf("  %-35s %s %-13s %s %-7s %s %-7s %s ",
+		"NAME", sep, "STATE", sep, "CPU", sep, "MEM", sep))
+	if showIOColumns {
+		containerList.WriteString(fmt.Sprintf("%-12s %s %-12s %s %-4s %s ",
+			"NET RX/TX", sep, "BLOCK R/W", sep, "PIDS", sep))
+	}
+	containerList.WriteString(fmt.Sprintf("%-6s %s %-16s %s %-7s %s %s\n",
+		"L/S", sep, "ERR/MIN", sep, "UPTIME", sep, "PORTS"))
 	containerList.WriteString(strings.Repeat("─", contentWidth) + "\n")
 
+	// Rows: one per container, or compose project headers + containers in grouped mode
+	rows := m.buildListRows(visibleContainers)
+
 	// Calculate scroll window for containers
 	startIdx := 0
-	endIdx := len(visibleContainers)
+	endIdx := len(rows)
 
 	// CRITICAL FIX: Validate indices before slice access to prevent index out of bounds panic
 	// Empty container list or invalid cursor position could cause crash
-	var displayContainers []types.Container
-	if len(visibleContainers) == 0 {
-		displayContainers = []types.Container{}
+	var displayRows []listRow
+	if len(rows) == 0 {
+		displayRows = []listRow{}
 	} else {
 		// Adjust window if cursor is out of visible range
 		if m.cursor >= availableForRows {
@@ -479,100 +704,45 @@ func (m *model) renderList() string {
 			if startIdx < 0 {
 				startIdx = 0
 			}
-			if startIdx >= len(visibleContainers) {
-				startIdx = max(0, len(visibleContainers)-1)
+			if startIdx >= len(rows) {
+				startIdx = max(0, len(rows)-1)
 			}
 		}
-		if startIdx+availableForRows < len(visibleContainers) {
+		if startIdx+availableForRows < len(rows) {
 			endIdx = startIdx + availableForRows
 		}
 
 		// CRITICAL FIX: Final validation before slice access
-		if startIdx >= len(visibleContainers) {
+		if startIdx >= len(rows) {
 			startIdx = 0
-			endIdx = min(availableForRows, len(visibleContainers))
+			endIdx = min(availableForRows, len(rows))
 		}
-		if endIdx > len(visibleContainers) {
-			endIdx = len(visibleContainers)
+		if endIdx > len(rows) {
+			endIdx = len(rows)
 		}
 		if startIdx > endIdx {
 			startIdx = 0
 			endIdx = 0
 		}
 
-		displayContainers = visibleContainers[startIdx:endIdx]
+		displayRows = rows[startIdx:endIdx]
 	}
 
-	// Container rows (use displayContainers)
+	// Container rows (use displayRows)
 	rowsRendered := 0
-	for i, c := range displayContainers {
+	for i, row := range displayRows {
 		rowsRendered++
-		actualIndex := startIdx + i // Index in the full container list
-		var line strings.Builder
-
-		// Selection mark with icons
-		// CRITICAL FIX: Protect read of m.selected with mutex to prevent race condition
-		m.selectedMu.RLock()
-		isSelected := m.selected[c.ID]
-		m.selectedMu.RUnlock()
-
-		if isSelected {
-			line.WriteString(selectedStyle.Render(iconSelected) + " ")
+		actualIndex := startIdx + i // Index in the full row list
+		var lineText string
+		if row.header {
+			lineText = m.renderProjectHeaderRow(row, sep, showIOColumns)
 		} else {
-			line.WriteString("  ")
+			lineText = m.renderContainerRow(row.container, row.project != "", sep, showIOColumns)
 		}
 
-		// Name (first column) - 35 chars + space + sep + space
-		name := getContainerName(c)
-		name = m.cleanContainerName(name) // Apply demo mode cleaning
-		if len(name) > 35 {
-			name = name[:32] + "..."
-		}
-		line.WriteString(fmt.Sprintf("%-35s ", name))
-		line.WriteString(sep + " ")
-
-		// State (second column) - 13 chars + space + sep + space
-		state := m.formatState(c)
-		line.WriteString(state + " ")
-		line.WriteString(sep + " ")
-
-		// CPU (third column) - 7 chars + space + sep + space
-		cpu := m.formatCPU(c.ID, c.State)
-		line.WriteString(cpu + " ")
-		line.WriteString(sep + " ")
-
-		// L/S (fourth column) - 6 chars + space + sep + space
-		logs := m.formatLogRate(c.ID, c.State)
-		line.WriteString(logs + " ")
-		line.WriteString(sep + " ")
-
-		// Uptime (fifth column) - 7 chars + space + sep + space
-		uptime := m.formatUptime(c.Status, c.State)
-		line.WriteString(uptime + " ")
-		line.WriteString(sep + " ")
-
-		// Ports (sixth column) - no trailing separator
-		ports := m.formatPorts(c.Ports)
-		line.WriteString(ports)
-
 		// Build final line with cursor background if applicable
-		lineText := line.String()
 		if actualIndex == m.cursor {
-			// For cursor line, we need to inject background color into every ANSI sequence
-			// Replace all ANSI reset codes and background codes with our background
-
-			// First, pad to full width
-			visualWidth := lipgloss.Width(lineText)
-			if visualWidth < contentWidth {
-				lineText = lineText + strings.Repeat(" ", contentWidth-visualWidth)
-			}
-
-			// Inject our background color after every SGR reset or at the start
-			// Pattern: replace \x1b[0m with \x1b[0m\x1b[48;2;38;79;120m to maintain background
-			// Also add background at the very start
-			lineText = "\x1b[48;2;38;79;120m" +
-				strings.ReplaceAll(lineText, "\x1b[0m", "\x1b[0m\x1b[48;2;38;79;120m") +
-				"\x1b[49m"
+			lineText = highlightCursorLine(lineText, contentWidth)
 		}
 
 		containerList.WriteString(lineText + "\n")
@@ -629,6 +799,440 @@ func (m *model) renderList() string {
 	return sb.String()
 }
 
+// renderProjectHeaderRow renders a compose project header with aggregate counts, CPU, memory and log rate
+func (m *model) renderProjectHeaderRow(row listRow, sep string, showIOColumns bool) string {
+	var line strings.Builder
+
+	// Selection mark: all project containers selected
+	m.selectedMu.RLock()
+	allSelected := len(row.members) > 0
+	for _, c := range row.members {
+		if !m.selected[c.ID] {
+			allSelected = false
+			break
+		}
+	}
+	m.selectedMu.RUnlock()
+
+	if allSelected {
+		line.WriteString(selectedStyle.Render(iconSelected) + " ")
+	} else {
+		line.WriteString("  ")
+	}
+
+	// Aggregates over running containers
+	running := 0
+	cpuTotal := 0.0
+	var memTotal uint64
+	rateTotal := 0.0
+	errorTotal := ErrorStats{History: make([]int, errorSparklineWidth)}
+	m.cpuStatsMu.RLock()
+	for _, c := range row.members {
+		if c.State != "running" {
+			continue
+		}
+		running++
+		cpuTotal += m.cpuCurrent[c.ID]
+		memTotal += m.statsCurrent[c.ID].MemUsage
+	}
+	m.cpuStatsMu.RUnlock()
+	if m.rateTracker != nil {
+		for _, c := range row.members {
+			if c.State == "running" {
+				rateTotal += m.rateTracker.GetRate(c.ID)
+				errors := m.rateTracker.GetErrorStats(c.ID)
+				errorTotal.PerMinute1m += errors.PerMinute1m
+				for i, count := range errors.History {
+					errorTotal.History[i] += count
+				}
+			}
+		}
+	}
+
+	// Name column: collapse icon + project name
+	icon := "▼"
+	if m.collapsedProjects[row.project] {
+		icon = "▶"
+	}
+	name := icon + " " + m.cleanContainerName(row.project)
+	if len([]rune(name)) > 35 {
+		name = string([]rune(name)[:32]) + "..."
+	}
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(fgBright))
+	line.WriteString(headerStyle.Render(fmt.Sprintf("%-35s", name)) + " ")
+	line.WriteString(sep + " ")
+
+	// State column: running/total, colored like container states
+	counts := fmt.Sprintf("%-13s", fmt.Sprintf("%d/%d running", running, len(row.members)))
+	switch {
+	case running == len(row.members):
+		line.WriteString(runningStyle.Render(counts) + " ")
+	case running == 0:
+		line.WriteString(stoppedStyle.Render(counts) + " ")
+	default:
+		line.WriteString(lipgloss.NewStyle().Foreground(lipgloss.Color(colorWarning)).Render(counts) + " ")
+	}
+	line.WriteString(sep + " ")
+
+	if running > 0 {
+		line.WriteString(formatCPUValue(cpuTotal) + " ")
+		line.WriteString(sep + " ")
+		line.WriteString(fmt.Sprintf("%7s", formatBytes(memTotal)) + " ")
+	} else {
+		line.WriteString(fmt.Sprintf("%7s", "") + " ")
+		line.WriteString(sep + " ")
+		line.WriteString(fmt.Sprintf("%7s", "") + " ")
+	}
+	line.WriteString(sep + " ")
+
+	if showIOColumns {
+		line.WriteString(fmt.Sprintf("%-12s %s %-12s %s %-4s %s ", "", sep, "", sep, "", sep))
+	}
+
+	if running > 0 {
+		line.WriteString(formatLogRateValue(rateTotal) + " ")
+		line.WriteString(sep + " ")
+		line.WriteString(formatErrorStats(errorTotal) + " ")
+	} else {
+		line.WriteString(fmt.Sprintf("%6s", "") + " ")
+		line.WriteString(sep + " ")
+		line.WriteString(fmt.Sprintf("%16s", "") + " ")
+	}
+	line.WriteString(sep + " ")
+
+	// Uptime and ports are per container
+	line.WriteString(fmt.Sprintf("%-7s", "") + " ")
+	line.WriteString(sep + " ")
+
+	return line.String()
+}
+
+// renderContainerRow renders one container row of the list (indented under its project header when grouped)
+func (m *model) renderContainerRow(c types.Container, indented bool, sep string, showIOColumns bool) string {
+	var line strings.Builder
+
+	// Selection mark with icons
+	// CRITICAL FIX: Protect read of m.selected with mutex to prevent race condition
+	m.selectedMu.RLock()
+	isSelected := m.selected[c.ID]
+	m.selectedMu.RUnlock()
+
+	if isSelected {
+		line.WriteString(selectedStyle.Render(iconSelected) + " ")
+	} else {
+		line.WriteString("  ")
+	}
+
+	// Name (first column) - 35 chars + space + sep + space
+	name := getContainerName(c)
+	name = m.cleanContainerName(name) // Apply demo mode cleaning
+	if indented {
+		name = "  " + name
+	}
+	if len(name) > 35 {
+		name = name[:32] + "..."
+	}
+	line.WriteString(fmt.Sprintf("%-35s ", name))
+	line.WriteString(sep + " ")
+
+	// State (second column) - 13 chars + space + sep + space
+	state := m.formatState(c)
+	line.WriteString(state + " ")
+	line.WriteString(sep + " ")
+
+	// CPU (third column) - 7 chars + space + sep + space
+	cpu := m.formatCPU(c.ID, c.State)
+	line.WriteString(cpu + " ")
+	line.WriteString(sep + " ")
+
+	// Memory (fourth column) - 7 chars + space + sep + space
+	mem := m.formatMemory(c.ID, c.State)
+	line.WriteString(mem + " ")
+	line.WriteString(sep + " ")
+
+	// Network, block I/O and PIDs (wide terminals only)
+	if showIOColumns {
+		line.WriteString(m.formatNetIO(c.ID, c.State) + " ")
+		line.WriteString(sep + " ")
+		line.WriteString(m.formatBlockIO(c.ID, c.State) + " ")
+		line.WriteString(sep + " ")
+		line.WriteString(m.formatPIDs(c.ID, c.State) + " ")
+		line.WriteString(sep + " ")
+	}
+
+	// L/S - 6 chars + space + sep + space
+	logs := m.formatLogRate(c.ID, c.State)
+	line.WriteString(logs + " ")
+	line.WriteString(sep + " ")
+
+	// ERR/MIN - 16 chars (rate + sparkline) + space + sep + space
+	errors := m.formatErrorRate(c.ID, c.State)
+	line.WriteString(errors + " ")
+	line.WriteString(sep + " ")
+
+	// Uptime - 7 chars + space + sep + space
+	uptime := m.formatUptime(c.Status, c.State)
+	line.WriteString(uptime + " ")
+	line.WriteString(sep + " ")
+
+	// Ports (last column) - no trailing separator
+	ports := m.formatPorts(c.Ports)
+	line.WriteString(ports)
+
+	return line.String()
+}
+
+// tableView describes a selectable list view rendered like the container list (images, ...)
+type tableView struct {
+	title         string
+	stats         string   // Right side of the title line
+	header        string   // Column headers (without selection prefix)
+	rows          []string // Pre-formatted rows (without selection prefix)
+	selected      []bool   // Selection state per row
+	cursor        int
+	footer        []string // Extra lines between the box and the help bar (e.g. progress)
+	selectionHelp string
+	actionsHelp   string
+	prompt        string // Input bar shown below the toast (empty = none)
+}
+
+// renderTableView renders a tableView with the same layout as renderList
+func (m *model) renderTableView(tv tableView) string {
+	var sb strings.Builder
+
+	if m.width < 40 || m.height < 10 {
+		return "Terminal too small. Please resize to at least 40x10."
+	}
+
+	// Fixed bottom lines: blank line + help bar + toast = 3 lines, plus optional footer and prompt
+	bottomLines := 3 + len(tv.footer)
+	if tv.prompt != "" {
+		bottomLines++
+	}
+	headerLines := 2
+	boxOverhead := 4
+	availableForRows := max(1, m.height-headerLines-bottomLines-boxOverhead)
+
+	// Header line: title on the left, stats on the right
+	title := titleStyle.Render(tv.title)
+	spacing := max(2, max(80, m.width)-lipgloss.Width(title)-lipgloss.Width(tv.stats)-2)
+	sb.WriteString(title + strings.Repeat(" ", spacing) + statusBarStyle.Render(tv.stats) + "\n\n")
+
+	contentWidth := max(80, m.width) - 6 // -6 for border + padding
+	var list strings.Builder
+	list.WriteString("  " + tv.header + "\n")
+	list.WriteString(strings.Repeat("─", contentWidth) + "\n")
+
+	// Scroll window keeping the cursor visible
+	startIdx := 0
+	if tv.cursor >= availableForRows {
+		startIdx = tv.cursor - availableForRows + 1
+	}
+	endIdx := min(len(tv.rows), startIdx+availableForRows)
+
+	rowsRendered := 0
+	for i := startIdx; i < endIdx; i++ {
+		prefix := "  "
+		if i < len(tv.selected) && tv.selected[i] {
+			prefix = selectedStyle.Render(iconSelected) + " "
+		}
+		lineText := prefix + tv.rows[i]
+		if i == tv.cursor {
+			lineText = highlightCursorLine(lineText, contentWidth)
+		}
+		list.WriteString(lineText + "\n")
+		rowsRendered++
+	}
+	for rowsRendered < availableForRows {
+		list.WriteString(strings.Repeat(" ", contentWidth) + "\n")
+		rowsRendered++
+	}
+	sb.WriteString(containerBoxStyle.Render(list.String()) + "\n")
+
+	for _, line := range tv.footer {
+		sb.WriteString(processingStyle.Render(line) + "\n")
+	}
+
+	// Help bar - selection on left, actions on right
+	sb.WriteString("\n")
+	helpSpacing := max(2, max(80, m.width)-lipgloss.Width(tv.selectionHelp)-lipgloss.Width(tv.actionsHelp)-2)
+	sb.WriteString(tv.selectionHelp + strings.Repeat(" ", helpSpacing) + tv.actionsHelp)
+
+	// Toast messages (fixed space to prevent UI jumping)
+	sb.WriteString("\n")
+	if m.toastMessage != "" {
+		if m.toastIsError {
+			sb.WriteString(toastErrorStyle.Render("✗ " + m.toastMessage))
+		} else {
+			sb.WriteString(toastSuccessStyle.Render("✓ " + m.toastMessage))
+		}
+	} else {
+		sb.WriteString(" ") // Reserve space even when no toast
+	}
+
+	if tv.prompt != "" {
+		sb.WriteString("\n" + tv.prompt)
+	}
+
+	return sb.String()
+}
+
+func (m *model) renderImages() string {
+	counts := m.imageContainerCounts()
+
+	// Stats: unique images, dangling count and total size (shared layers counted once per image)
+	seen := make(map[string]bool)
+	var totalSize int64
+	dangling := 0
+	for _, row := range m.imageRows {
+		if row.tag == "<none>" {
+			dangling++
+		}
+		if !seen[row.id] {
+			seen[row.id] = true
+			totalSize += row.size
+		}
+	}
+	stats := fmt.Sprintf("Images: %d │ Dangling: %d │ Size: %s", len(seen), dangling, formatBytes(uint64(totalSize)))
+	if m.imagesLoading {
+		stats = spinnerFrames[m.spinnerFrame] + " " + stats
+	}
+
+	sepStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(colorSeparator))
+	sep := sepStyle.Render("│")
+
+	tv := tableView{
+		title:         "🖼  Images",
+		stats:         stats,
+		header:        fmt.Sprintf("%-45s %s %-20s %s %-12s %s %8s %s %-9s %s %s", "REPOSITORY", sep, "TAG", sep, "IMAGE ID", sep, "SIZE", sep, "CREATED", sep, "CONTAINERS"),
+		cursor:        m.imagesCursor,
+		selectionHelp: "[SPACE] Select  [A] All  [X] Clear  [I] Invert",
+		actionsHelp:   "[U] Pull  [D] Remove  [P] Prune Dangling  [R] Refresh  [TAB] Volumes  [Q/ESC] Containers",
+	}
+
+	for _, row := range m.imageRows {
+		repository := row.repository
+		if len(repository) > 45 {
+			repository = "..." + repository[len(repository)-42:]
+		}
+		tag := row.tag
+		if len(tag) > 20 {
+			tag = tag[:17] + "..."
+		}
+		used := fmt.Sprintf("%d", counts[row.id])
+		if counts[row.id] == 0 {
+			used = lipgloss.NewStyle().Foreground(lipgloss.Color(fgDim)).Render("0")
+		}
+		tv.rows = append(tv.rows, fmt.Sprintf("%-45s %s %-20s %s %-12s %s %8s %s %-9s %s %s",
+			repository, sep, tag, sep, row.shortID(), sep, formatBytes(uint64(row.size)), sep, formatAge(row.created), sep, used))
+		tv.selected = append(tv.selected, m.imagesSelected[row.ref()])
+	}
+
+	// Pull progress: global status + most recent layers
+	if m.imagePull != nil {
+		lines := m.imagePull.lines()
+		const maxLayers = 5
+		if len(lines) > maxLayers+1 {
+			lines = append(lines[:1], lines[len(lines)-maxLayers:]...)
+		}
+		tv.footer = lines
+	}
+
+	if m.pullPromptMode {
+		tv.prompt = "Pull image: " + m.pullPromptInput + "█"
+	}
+
+	return m.renderTableView(tv)
+}
+
+func (m *model) renderVolumes() string {
+	attached := m.volumeContainers()
+
+	used := 0
+	for _, row := range m.volumeRows {
+		if len(attached[row.name]) > 0 {
+			used++
+		}
+	}
+	stats := fmt.Sprintf("Volumes: %d │ In use: %d │ Unused: %d", len(m.volumeRows), used, len(m.volumeRows)-used)
+	if m.volumesLoading {
+		stats = spinnerFrames[m.spinnerFrame] + " " + stats
+	}
+
+	sep := lipgloss.NewStyle().Foreground(lipgloss.Color(colorSeparator)).Render("│")
+	// Containers column takes the remaining width (selection prefix + fixed columns + separators)
+	containersWidth := max(10, max(80, m.width)-6-2-(30+8+6+40)-4*3)
+
+	tv := tableView{
+		title:         "💾 Volumes",
+		stats:         stats,
+		header:        fmt.Sprintf("%-30s %s %-8s %s %-6s %s %-40s %s %s", "NAME", sep, "DRIVER", sep, "SCOPE", sep, "MOUNTPOINT", sep, "CONTAINERS"),
+		cursor:        m.volumesCursor,
+		selectionHelp: "[SPACE] Select  [A] All  [X] Clear  [I] Invert",
+		actionsHelp:   "[D] Remove  [P] Prune  [R] Refresh  [TAB] Networks  [Q/ESC] Containers",
+	}
+
+	for _, row := range m.volumeRows {
+		mountpoint := row.mountpoint
+		if len(mountpoint) > 40 {
+			mountpoint = "..." + mountpoint[len(mountpoint)-37:]
+		}
+		containers := lipgloss.NewStyle().Foreground(lipgloss.Color(fgDim)).Render("-")
+		if names := attached[row.name]; len(names) > 0 {
+			containers = truncateText(strings.Join(names, ", "), containersWidth)
+		}
+		tv.rows = append(tv.rows, fmt.Sprintf("%-30s %s %-8s %s %-6s %s %-40s %s %s",
+			truncateText(row.name, 30), sep, truncateText(row.driver, 8), sep, truncateText(row.scope, 6), sep, mountpoint, sep, containers))
+		tv.selected = append(tv.selected, m.volumesSelected[row.name])
+	}
+
+	return m.renderTableView(tv)
+}
+
+func (m *model) renderNetworks() string {
+	attached := m.networkContainers()
+
+	sep := lipgloss.NewStyle().Foreground(lipgloss.Color(colorSeparator)).Render("│")
+	containersWidth := max(10, max(80, m.width)-6-2-(25+12+8+6+18)-5*3)
+
+	tv := tableView{
+		title:         "🔗 Networks",
+		stats:         fmt.Sprintf("Networks: %d", len(m.networkRows)),
+		header:        fmt.Sprintf("%-25s %s %-12s %s %-8s %s %-6s %s %-18s %s %s", "NAME", sep, "NETWORK ID", sep, "DRIVER", sep, "SCOPE", sep, "SUBNET", sep, "CONTAINERS"),
+		cursor:        m.networksCursor,
+		selectionHelp: "[SPACE] Select  [A] All  [X] Clear  [I] Invert",
+		actionsHelp:   "[C] Connect  [U] Disconnect  [D] Remove  [P] Prune  [R] Refresh  [TAB/Q/ESC] Containers",
+	}
+	if m.networksLoading {
+		tv.stats = spinnerFrames[m.spinnerFrame] + " " + tv.stats
+	}
+
+	for _, row := range m.networkRows {
+		containers := lipgloss.NewStyle().Foreground(lipgloss.Color(fgDim)).Render("-")
+		if members := row.containers(attached); len(members) > 0 {
+			names := make([]string, 0, len(members))
+			for _, c := range members {
+				names = append(names, c.name)
+			}
+			containers = truncateText(strings.Join(names, ", "), containersWidth)
+		}
+		subnet := row.subnet
+		if subnet == "" {
+			subnet = "-"
+		}
+		tv.rows = append(tv.rows, fmt.Sprintf("%-25s %s %-12s %s %-8s %s %-6s %s %-18s %s %s",
+			truncateText(row.name, 25), sep, row.shortID(), sep, truncateText(row.driver, 8), sep, truncateText(row.scope, 6), sep, truncateText(subnet, 18), sep, containers))
+		tv.selected = append(tv.selected, m.networksSelected[row.id])
+	}
+
+	// Connect/disconnect applies to the containers selected in the container list
+	if count := m.countSelected(); count > 0 {
+		tv.footer = []string{fmt.Sprintf("%d container(s) selected in the container list for [C] Connect / [U] Disconnect", count)}
+	}
+
+	return m.renderTableView(tv)
+}
+
 // renderMCPLogs renders the MCP server logs popup
 func (m *model) renderMCPLogs() string {
 	var sb strings.Builder
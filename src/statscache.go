@@ -0,0 +1,113 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/docker/docker/client"
+)
+
+// statsHistoryLength is the number of samples kept per container (same as the CPU history)
+const statsHistoryLength = 10
+
+// StatsCache caches container stats (CPU, memory, I/O, PIDs) for instant MCP responses
+type StatsCache struct {
+	mu          sync.RWMutex
+	current     map[string]ContainerStats   // containerID -> latest stats
+	history     map[string][]ContainerStats // containerID -> last statsHistoryLength samples
+	lastRefresh time.Time
+}
+
+// NewStatsCache creates a new stats cache
+func NewStatsCache() *StatsCache {
+	return &StatsCache{
+		current: make(map[string]ContainerStats),
+		history: make(map[string][]ContainerStats),
+	}
+}
+
+// Update updates the cache with new stats (called by model when it receives cpuStatsMsg, or by pollStats)
+// Containers missing from stats are dropped from the cache.
+func (c *StatsCache) Update(stats map[string]ContainerStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Replace entire map with new values
+	c.current = make(map[string]ContainerStats, len(stats))
+	history := make(map[string][]ContainerStats, len(stats))
+	for id, s := range stats {
+		c.current[id] = s
+
+		h := append(c.history[id], s)
+		if len(h) > statsHistoryLength {
+			h = h[len(h)-statsHistoryLength:]
+		}
+		history[id] = h
+	}
+	c.history = history
+	c.lastRefresh = time.Now()
+}
+
+// Get returns cached stats (non-blocking, instant response)
+func (c *StatsCache) Get() map[string]ContainerStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	// Return a copy to avoid race conditions
+	result := make(map[string]ContainerStats, len(c.current))
+	for k, v := range c.current {
+		result[k] = v
+	}
+	return result
+}
+
+// GetForContainer returns cached stats for a specific container
+func (c *StatsCache) GetForContainer(containerID string) (ContainerStats, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.current[containerID]
+	return s, ok
+}
+
+// GetHistory returns a copy of the cached samples for a specific container (oldest first)
+func (c *StatsCache) GetHistory(containerID string) []ContainerStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	result := make([]ContainerStats, len(c.history[containerID]))
+	copy(result, c.history[containerID])
+	return result
+}
+
+// GetLastRefresh returns when the cache was last refreshed
+func (c *StatsCache) GetLastRefresh() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastRefresh
+}
+
+// pollStats fills cache every interval until stop is closed, like the TUI does with cpuTickMsg
+// Used when no TUI runs (HTTP-only MCP server mode).
+func pollStats(cli *client.Client, cache *StatsCache, interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	stats := newHostStats()
+	for {
+		if containers, err := loadContainersSync(cli); err == nil {
+			currentIDs := make(map[string]bool, len(containers))
+			for _, c := range containers {
+				currentIDs[c.ID] = true
+			}
+			stats.prune(currentIDs)
+			if msg, ok := fetchCPUStats(cli, containers, stats.cpuPrevStats)().(cpuStatsMsg); ok {
+				cache.Update(stats.apply(msg))
+			}
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/client"
+)
+
+// TestStatsCacheUpdate tests current values, history limit and stale container removal
+func TestStatsCacheUpdate(t *testing.T) {
+	cache := NewStatsCache()
+
+	for i := 1; i <= statsHistoryLength+2; i++ {
+		cache.Update(map[string]ContainerStats{
+			"c1": {CPUPercent: float64(i), MemUsage: uint64(i)},
+			"c2": {CPUPercent: 1},
+		})
+	}
+
+	current, ok := cache.GetForContainer("c1")
+	if !ok || current.MemUsage != statsHistoryLength+2 {
+		t.Errorf("Expected latest MemUsage=%d, got %+v", statsHistoryLength+2, current)
+	}
+
+	history := cache.GetHistory("c1")
+	if len(history) != statsHistoryLength {
+		t.Fatalf("Expected %d history entries, got %d", statsHistoryLength, len(history))
+	}
+	if history[0].MemUsage != 3 {
+		t.Errorf("Expected oldest kept sample MemUsage=3, got %d", history[0].MemUsage)
+	}
+
+	// Containers missing from an update are dropped
+	cache.Update(map[string]ContainerStats{"c1": {}})
+	if _, ok := cache.GetForContainer("c2"); ok {
+		t.Error("Expected c2 to be dropped from cache")
+	}
+	if len(cache.GetHistory("c2")) != 0 {
+		t.Error("Expected c2 history to be dropped from cache")
+	}
+	if len(cache.Get()) != 1 {
+		t.Errorf("Expected 1 cached container, got %d", len(cache.Get()))
+	}
+}
+
+// TestPollStats tests that the cache is filled without the TUI (HTTP-only MCP server mode)
+func TestPollStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/containers/json"):
+			w.Write([]byte(`[{"Id":"0123456789abcdef","Names":["/web"],"State":"running"}]`))
+		case strings.HasSuffix(r.URL.Path, "/stats"):
+			w.Write([]byte(`{"read":"2025-03-01T10:20:30Z","memory_stats":{"usage":2048,"limit":4096},"pids_stats":{"current":3}}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	cli, err := client.NewClientWithOpts(client.WithHost("tcp://"+strings.TrimPrefix(server.URL, "http://")), client.WithVersion("1.43"))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	defer cli.Close()
+
+	cache := NewStatsCache()
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		pollStats(cli, cache, time.Hour, stop)
+		close(done)
+	}()
+
+	// The first poll runs immediately
+	deadline := time.Now().Add(5 * time.Second)
+	for cache.GetLastRefresh().IsZero() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(stop)
+	<-done
+
+	stats, ok := cache.GetForContainer("0123456789abcdef")
+	if !ok || stats.MemUsage != 2048 || stats.MemPercent != 50 || stats.PIDs != 3 {
+		t.Errorf("Expected polled memory and PID stats, got %+v (ok=%v)", stats, ok)
+	}
+}
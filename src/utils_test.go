@@ -171,8 +171,8 @@ func TestRateTrackerConsumer_CleanupStaleContainers_NoStale(t *testing.T) {
 	rtc := NewRateTrackerConsumer()
 
 	// Add some recent logs
-	rtc.OnLogLine("c1", "container1", "log", time.Now())
-	rtc.OnLogLine("c2", "container2", "log", time.Now())
+	rtc.OnLogLine("c1", "container1", "log", streamStdout, time.Now())
+	rtc.OnLogLine("c2", "container2", "log", streamStdout, time.Now())
 
 	// Run cleanup
 	rtc.CleanupStaleContainers()
@@ -188,10 +188,10 @@ func TestRateTrackerConsumer_CleanupStaleContainers_WithStale(t *testing.T) {
 
 	// Add a container with old timestamp
 	oldTime := time.Now().Add(-10 * time.Minute)
-	rtc.OnLogLine("stale", "stale-container", "log", oldTime)
+	rtc.OnLogLine("stale", "stale-container", "log", streamStdout, oldTime)
 
 	// Add a recent container
-	rtc.OnLogLine("active", "active-container", "log", time.Now())
+	rtc.OnLogLine("active", "active-container", "log", streamStdout, time.Now())
 
 	// Manually set the lastUpdate to be old for stale container
 	rtc.ratesMu.Lock()
@@ -229,7 +229,7 @@ func TestRateTrackerConsumer_CleanupStaleContainers_AllStale(t *testing.T) {
 
 	for i := 0; i < 5; i++ {
 		containerID := string('a' + rune(i))
-		rtc.OnLogLine(containerID, "container", "log", oldTime)
+		rtc.OnLogLine(containerID, "container", "log", streamStdout, oldTime)
 
 		// Manually set old lastUpdate
 		rtc.ratesMu.Lock()
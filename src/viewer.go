@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Offline viewer: "docker-tui view <files...>" loads saved logs into the logs view (filter,
+// highlight, levels, pretty mode, colors by source) without contacting a Docker daemon.
+//
+// Line formats, detected per line:
+//
+//	{"time":...,"container":...,"stream":...,"line":...}     docker-tui --log-combined
+//	{"log":"...\n","stream":"stderr","time":...}             Docker json-file driver
+//	2025-03-01T10:20:30.123Z stderr message                  docker-tui --log-dir files
+//	2025-03-01T10:20:30.123456789Z message                   docker logs --timestamps
+//	message                                                  plain text (time of the previous line)
+//
+// Files ending in .gz (rotated --log-dir files) are decompressed. Lines are colored by source:
+// the container of JSONL records, otherwise the file name without extensions.
+
+// viewerRecord holds the keys of the JSON line formats
+type viewerRecord struct {
+	Time      *string `json:"time"`
+	Container *string `json:"container"`
+	Stream    *string `json:"stream"`
+	Line      *string `json:"line"` // docker-tui combined file
+	Log       *string `json:"log"`  // Docker json-file driver
+}
+
+// viewerSource is the lines of one source (container or file) of a loaded file
+type viewerSource struct {
+	name    string
+	entries []LogEntry
+}
+
+// viewerRotationSuffixRe matches the rotation suffix of --log-dir files
+var viewerRotationSuffixRe = regexp.MustCompile(`\.\d{8}-\d{6}\.\d{3}$`)
+
+// viewerSourceName returns the source name of a file: base name without .gz, rotation suffix
+// and log extensions (api.log.20250301-102030.000.gz → api)
+func viewerSourceName(path string) string {
+	name := strings.TrimSuffix(filepath.Base(path), ".gz")
+	name = viewerRotationSuffixRe.ReplaceAllString(name, "")
+	for _, ext := range []string{".log", ".jsonl", ".json", ".txt"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	if name == "" {
+		return filepath.Base(path)
+	}
+	return name
+}
+
+// loadViewerFile reads a saved log file, grouped by source in order of appearance
+func loadViewerFile(path string) ([]viewerSource, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		zr, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		defer zr.Close()
+		r = zr
+	}
+	return parseViewerLines(r, viewerSourceName(path))
+}
+
+// parseViewerLines parses log lines of the supported formats (see above)
+func parseViewerLines(r io.Reader, fileSource string) ([]viewerSource, error) {
+	sources := []viewerSource{}
+	index := make(map[string]int)
+	var last time.Time
+
+	reader := bufio.NewReader(r)
+	for {
+		raw, err := reader.ReadString('\n')
+		if raw == "" && err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		raw = strings.TrimRight(raw, "\r\n")
+
+		entry, source := parseViewerLine(raw, fileSource)
+		if entry.Timestamp.IsZero() {
+			entry.Timestamp = last // Continuation lines (stack traces) follow the previous line
+		}
+		last = entry.Timestamp
+
+		i, ok := index[source]
+		if !ok {
+			i = len(sources)
+			index[source] = i
+			sources = append(sources, viewerSource{name: source})
+		}
+		sources[i].entries = append(sources[i].entries, entry)
+	}
+	return sources, nil
+}
+
+// parseViewerLine parses one line into an entry (zero time when the line has none) and its source
+func parseViewerLine(raw, fileSource string) (LogEntry, string) {
+	if strings.HasPrefix(raw, "{") {
+		var record viewerRecord
+		if json.Unmarshal([]byte(raw), &record) == nil && record.Time != nil && (record.Line != nil || record.Log != nil) {
+			entry := LogEntry{}
+			entry.Timestamp, _ = time.Parse(time.RFC3339Nano, *record.Time)
+			if record.Line != nil {
+				entry.Line = *record.Line
+			} else {
+				entry.Line = strings.TrimRight(*record.Log, "\r\n")
+			}
+			if record.Stream != nil {
+				entry.Stream = *record.Stream
+			}
+			source := fileSource
+			if record.Container != nil && *record.Container != "" {
+				source = strings.TrimPrefix(*record.Container, "/")
+			}
+			return entry, source
+		}
+		// Application JSON line: plain text (fields are parsed by the logs view)
+	}
+
+	entry := LogEntry{Line: raw}
+	if idx := strings.IndexByte(raw, ' '); idx > 0 {
+		if ts, err := time.Parse(time.RFC3339Nano, raw[:idx]); err == nil {
+			entry.Timestamp = ts
+			entry.Line = raw[idx+1:]
+			for _, stream := range []string{streamStdout, streamStderr} {
+				if strings.HasPrefix(entry.Line, stream+" ") {
+					entry.Stream = stream
+					entry.Line = entry.Line[len(stream)+1:]
+					break
+				}
+			}
+		}
+	} else if ts, err := time.Parse(time.RFC3339Nano, raw); err == nil {
+		entry.Timestamp = ts
+		entry.Line = ""
+	}
+	return entry, fileSource
+}
+
+// newViewerModel creates a model showing the given files in the logs view
+func newViewerModel(paths []string) (*model, error) {
+	ids := []string{}
+	entries := make(map[string][]LogEntry)
+	names := make(map[string]string)
+	total := 0
+	maxNameWidth := 0
+
+	// One merge source per (file, source): each is in file order, the merge orders them by time
+	for i, path := range paths {
+		sources, err := loadViewerFile(path)
+		if err != nil {
+			return nil, err
+		}
+		for _, source := range sources {
+			id := fmt.Sprintf("%d:%s", i, source.name)
+			ids = append(ids, id)
+			entries[id] = source.entries
+			names[id] = source.name
+			total += len(source.entries)
+			maxNameWidth = max(maxNameWidth, len(source.name))
+		}
+	}
+
+	files := make([]string, len(paths))
+	for i, path := range paths {
+		files[i] = filepath.Base(path)
+	}
+
+	m := &model{
+		selected:             make(map[string]bool),
+		processing:           make(map[string]bool),
+		view:                 logsView,
+		logsColorEnabled:     true,
+		logsViewMaxNameWidth: maxNameWidth,
+		wasAtBottom:          true,
+		viewerFiles:          files,
+	}
+	// The whole files are kept: the buffer is sized to the number of lines
+	m.bufferConsumer = NewBufferConsumer(ids, max(100, total), nil, &m.logChanClosing, &m.logChanWg)
+	m.bufferConsumer.PreloadEntries(ids, entries, names)
+	return m, nil
+}
+
+// runViewer runs "docker-tui view <files...>"
+func runViewer(args []string) {
+	paths := []string{}
+	for _, arg := range args {
+		switch arg {
+		case "--help", "-h":
+			printViewerUsage()
+			os.Exit(0)
+		default:
+			paths = append(paths, arg)
+		}
+	}
+	if len(paths) == 0 {
+		printViewerUsage()
+		os.Exit(1)
+	}
+
+	m, err := newViewerModel(paths)
+	if err != nil {
+		fmt.Printf("Error loading log files: %v\n", err)
+		os.Exit(1)
+	}
+
+	p := tea.NewProgram(m, tea.WithAltScreen(), tea.WithMouseAllMotion())
+	if _, err := p.Run(); err != nil {
+		fmt.Printf("Error running program: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// printViewerUsage prints the help of "docker-tui view"
+func printViewerUsage() {
+	fmt.Println("Usage: docker-tui view FILE [FILE...]")
+	fmt.Println()
+	fmt.Println("Open saved logs in the logs view, without a Docker daemon. Supported formats:")
+	fmt.Println("  - docker logs --timestamps output, or plain text")
+	fmt.Println("  - docker-tui --log-dir files (also rotated .gz files) and --log-combined JSONL")
+	fmt.Println("  - Docker json-file driver logs (/var/lib/docker/containers/<id>/<id>-json.log)")
+	fmt.Println()
+	fmt.Println("Lines of all files are merged by time and colored by container (or file).")
+	fmt.Println("Q/ESC quits.")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestViewerSourceName tests source names derived from file names
+func TestViewerSourceName(t *testing.T) {
+	tests := map[string]string{
+		"/tmp/bundle/api.log":                         "api",
+		"api.log.20250301-102030.123.gz":              "api",
+		"docker-tui.jsonl":                            "docker-tui",
+		"worker.txt":                                  "worker",
+		"nginx-access":                                "nginx-access",
+		"/var/lib/docker/containers/abc/abc-json.log": "abc-json",
+		".log": ".log",
+	}
+	for path, want := range tests {
+		if got := viewerSourceName(path); got != want {
+			t.Errorf("viewerSourceName(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+// TestParseViewerLine tests the supported line formats
+func TestParseViewerLine(t *testing.T) {
+	ts := time.Date(2025, 3, 1, 10, 20, 30, 123000000, time.UTC)
+	tests := []struct {
+		name   string
+		raw    string
+		line   string
+		stream string
+		source string
+		time   time.Time
+	}{
+		{"combined JSONL", `{"time":"2025-03-01T10:20:30.123Z","container_id":"abc","container":"api","stream":"stderr","line":"boom"}`, "boom", streamStderr, "api", ts},
+		{"json-file driver", `{"log":"GET /health\n","stream":"stdout","time":"2025-03-01T10:20:30.123Z"}`, "GET /health", streamStdout, "file", ts},
+		{"log-dir file", "2025-03-01T10:20:30.123Z stderr connection refused", "connection refused", streamStderr, "file", ts},
+		{"docker logs --timestamps", "2025-03-01T10:20:30.123Z GET /health 200", "GET /health 200", "", "file", ts},
+		{"application JSON", `{"level":"info","msg":"started"}`, `{"level":"info","msg":"started"}`, "", "file", time.Time{}},
+		{"plain text", "at Foo.bar(Foo.java:42)", "at Foo.bar(Foo.java:42)", "", "file", time.Time{}},
+	}
+	for _, tt := range tests {
+		entry, source := parseViewerLine(tt.raw, "file")
+		if entry.Line != tt.line || entry.Stream != tt.stream || source != tt.source || !entry.Timestamp.Equal(tt.time) {
+			t.Errorf("%s: got line=%q stream=%q source=%q time=%v", tt.name, entry.Line, entry.Stream, source, entry.Timestamp)
+		}
+	}
+}
+
+// TestNewViewerModel tests loading several files merged by time into the logs view
+func TestNewViewerModel(t *testing.T) {
+	dir := t.TempDir()
+
+	api := filepath.Join(dir, "api.log")
+	os.WriteFile(api, []byte(
+		"2025-03-01T10:00:00Z stdout started\n"+
+			"2025-03-01T10:00:02Z stderr panic: boom\n"+
+			"goroutine 1 [running]:\n"), 0o644)
+
+	// Rotated file of the combined JSONL sink
+	combined := filepath.Join(dir, "docker-tui.jsonl.20250301-100000.000.gz")
+	f, _ := os.Create(combined)
+	zw := gzip.NewWriter(f)
+	zw.Write([]byte(`{"time":"2025-03-01T10:00:01Z","container_id":"d1","container":"db","stream":"stdout","line":"ready"}` + "\n"))
+	zw.Close()
+	f.Close()
+
+	m, err := newViewerModel([]string{api, combined})
+	if err != nil {
+		t.Fatalf("newViewerModel: %v", err)
+	}
+	if m.view != logsView || m.bufferConsumer == nil {
+		t.Fatal("Expected the logs view with a buffer")
+	}
+	if m.logsViewMaxNameWidth != 3 {
+		t.Errorf("logsViewMaxNameWidth = %d, want 3", m.logsViewMaxNameWidth)
+	}
+
+	lines := m.logsViewLines()
+	want := []string{"[api] started", "[db] ready", "[api] panic: boom", "[api] goroutine 1 [running]:"}
+	if len(lines) != len(want) {
+		t.Fatalf("Expected %d lines, got %d", len(want), len(lines))
+	}
+	for i, line := range lines {
+		if line.text != want[i] {
+			t.Errorf("Line %d = %q, want %q", i, line.text, want[i])
+		}
+	}
+	if lines[2].stream != streamStderr || lines[2].level != levelFatal {
+		t.Errorf("Expected a fatal stderr line, got stream=%q level=%v", lines[2].stream, lines[2].level)
+	}
+	if !lines[3].timestamp.Equal(lines[2].timestamp) {
+		t.Error("Expected the continuation line to keep the previous time")
+	}
+
+	if _, err := newViewerModel([]string{filepath.Join(dir, "missing.log")}); err == nil {
+		t.Error("Expected an error for a missing file")
+	}
+}
+
+// TestViewerKeys tests that ESC clears the filter, then quits the viewer
+func TestViewerKeys(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "api.log")
+	os.WriteFile(path, []byte("hello\n"), 0o644)
+
+	m, err := newViewerModel([]string{path})
+	if err != nil {
+		t.Fatalf("newViewerModel: %v", err)
+	}
+	m.width, m.height = 120, 30
+	if m.Init() != nil {
+		t.Error("Expected no command without a Docker daemon")
+	}
+	if view := m.renderLogs(); !strings.Contains(view, "api.log") || !strings.Contains(view, "[Q/ESC] Quit") {
+		t.Errorf("Expected file name and quit help in view:\n%s", view)
+	}
+
+	m.filterActive = "hello"
+	_, cmd := m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.filterActive != "" || cmd != nil {
+		t.Error("Expected the first ESC to clear the filter")
+	}
+
+	_, cmd = m.handleLogsViewKeys(tea.KeyMsg{Type: tea.KeyEsc})
+	if cmd == nil {
+		t.Fatal("Expected a quit command")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("Expected tea.QuitMsg")
+	}
+}
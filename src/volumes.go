@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+	"github.com/docker/docker/client"
+)
+
+// volumeRow is one line of the volumes view
+type volumeRow struct {
+	name       string
+	driver     string
+	scope      string
+	mountpoint string
+}
+
+// volumeListMsg carries the result of VolumeList
+type volumeListMsg struct {
+	volumes []*volume.Volume
+	err     error
+}
+
+// loadVolumes lists volumes
+func loadVolumes(cli *client.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		resp, err := cli.VolumeList(ctx, volume.ListOptions{})
+		return volumeListMsg{volumes: resp.Volumes, err: err}
+	}
+}
+
+// buildVolumeRows converts volumes into rows sorted by name
+func buildVolumeRows(volumes []*volume.Volume) []volumeRow {
+	rows := make([]volumeRow, 0, len(volumes))
+	for _, v := range volumes {
+		if v == nil {
+			continue
+		}
+		rows = append(rows, volumeRow{
+			name:       v.Name,
+			driver:     v.Driver,
+			scope:      v.Scope,
+			mountpoint: v.Mountpoint,
+		})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].name < rows[j].name })
+	return rows
+}
+
+// volumeContainers returns the names of the containers mounting each volume (from the container list)
+func (m *model) volumeContainers() map[string][]string {
+	attached := make(map[string][]string)
+	m.containersMu.RLock()
+	for _, c := range m.containers {
+		for _, mp := range c.Mounts {
+			if mp.Type == mount.TypeVolume && mp.Name != "" {
+				attached[mp.Name] = append(attached[mp.Name], m.cleanContainerName(getContainerName(c)))
+			}
+		}
+	}
+	m.containersMu.RUnlock()
+	return attached
+}
+
+// selectedVolumeNames returns the selected volume names in display order, or the cursor row
+func (m *model) selectedVolumeNames() []string {
+	names := []string{}
+	for _, row := range m.volumeRows {
+		if m.volumesSelected[row.name] {
+			names = append(names, row.name)
+		}
+	}
+	if len(names) == 0 && m.volumesCursor >= 0 && m.volumesCursor < len(m.volumeRows) {
+		names = append(names, m.volumeRows[m.volumesCursor].name)
+	}
+	return names
+}
+
+// removeVolumes removes the given volumes (volumes in use are kept: no force)
+func removeVolumes(cli *client.Client, names []string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		var errors []string
+		successCount := 0
+		for _, name := range names {
+			if err := cli.VolumeRemove(ctx, name, false); err != nil {
+				errors = append(errors, fmt.Sprintf("%s: %v", name, err))
+				continue
+			}
+			successCount++
+		}
+
+		return tea.Batch(
+			loadVolumes(cli),
+			func() tea.Msg { return resultToast("remove", "volume", successCount, errors) },
+		)()
+	}
+}
+
+// pruneVolumes removes unused volumes (anonymous ones only, like `docker volume prune`)
+func pruneVolumes(cli *client.Client) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		report, err := cli.VolumesPrune(ctx, filters.NewArgs())
+		if err != nil {
+			return toastMsg{message: fmt.Sprintf("prune failed: %v", err), isError: true}
+		}
+
+		return tea.Batch(
+			loadVolumes(cli),
+			func() tea.Msg {
+				return toastMsg{message: fmt.Sprintf("prune: %d volume(s) deleted, %s reclaimed",
+					len(report.VolumesDeleted), formatBytes(report.SpaceReclaimed))}
+			},
+		)()
+	}
+}
+
+// resultToast summarizes a batch operation on resources (e.g. "remove: 2 volume(s) removed")
+func resultToast(action, kind string, successCount int, errors []string) toastMsg {
+	switch {
+	case len(errors) == 0:
+		return toastMsg{message: fmt.Sprintf("%s: %d %s(s) %s", action, successCount, kind, pastTense(action))}
+	case successCount > 0:
+		return toastMsg{message: fmt.Sprintf("%s: %d succeeded, %d failed", action, successCount, len(errors)), isError: true}
+	default:
+		return toastMsg{message: fmt.Sprintf("%s failed: %s", action, errors[0]), isError: true}
+	}
+}
+
+// pastTense returns the participle used in resultToast messages
+func pastTense(action string) string {
+	switch action {
+	case "remove":
+		return "removed"
+	case "connect":
+		return "connected"
+	case "disconnect":
+		return "disconnected"
+	}
+	return action + "ed"
+}
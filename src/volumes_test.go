@@ -0,0 +1,94 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
+)
+
+func TestBuildVolumeRows(t *testing.T) {
+	rows := buildVolumeRows([]*volume.Volume{
+		{Name: "pgdata", Driver: "local", Scope: "local", Mountpoint: "/var/lib/docker/volumes/pgdata/_data"},
+		nil,
+		{Name: "cache", Driver: "local", Scope: "local"},
+	})
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].name != "cache" || rows[1].name != "pgdata" {
+		t.Errorf("rows should be sorted by name, got %s, %s", rows[0].name, rows[1].name)
+	}
+}
+
+func TestVolumeContainers(t *testing.T) {
+	m := createTestModel()
+	m.containers = []types.Container{
+		{ID: "c1", Names: []string{"/db"}, Mounts: []container.MountPoint{
+			{Type: mount.TypeVolume, Name: "pgdata"},
+			{Type: mount.TypeBind, Source: "/etc/conf"},
+		}},
+		{ID: "c2", Names: []string{"/backup"}, Mounts: []container.MountPoint{{Type: mount.TypeVolume, Name: "pgdata"}}},
+	}
+
+	attached := m.volumeContainers()
+	if strings.Join(attached["pgdata"], ",") != "db,backup" {
+		t.Errorf("pgdata containers = %v", attached["pgdata"])
+	}
+	if len(attached) != 1 {
+		t.Errorf("bind mounts should be ignored, got %v", attached)
+	}
+}
+
+func TestVolumesViewKeys(t *testing.T) {
+	m := createTestModel()
+	m.width = 160
+
+	// TAB cycles list -> images -> volumes -> networks -> list
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyTab})
+	if m.view != volumesView {
+		t.Fatalf("second TAB should open the volumes view, got %v", m.view)
+	}
+
+	m.Update(volumeListMsg{volumes: []*volume.Volume{{Name: "a"}, {Name: "b"}}})
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnd})
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}})
+	if !m.volumesSelected["b"] {
+		t.Error("space should select the cursor volume")
+	}
+
+	if !strings.Contains(m.View(), "Volumes: 2") {
+		t.Error("volumes view should show the volume count")
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	if m.view != confirmView || m.pendingAction != "remove-volumes" || m.confirmReturnView != volumesView {
+		t.Fatalf("d should ask for confirmation, got view %v action %q", m.view, m.pendingAction)
+	}
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if m.view != volumesView {
+		t.Errorf("cancel should return to the volumes view, got %v", m.view)
+	}
+
+	m.handleKeyPress(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if m.view != imagesView {
+		t.Errorf("shift+tab should go back to the images view, got %v", m.view)
+	}
+}
+
+func TestResultToast(t *testing.T) {
+	if toast := resultToast("remove", "volume", 2, nil); toast.isError || toast.message != "remove: 2 volume(s) removed" {
+		t.Errorf("unexpected success toast %#v", toast)
+	}
+	if toast := resultToast("connect", "container", 1, []string{"x: boom"}); !toast.isError || toast.message != "connect: 1 succeeded, 1 failed" {
+		t.Errorf("unexpected partial toast %#v", toast)
+	}
+	if toast := resultToast("remove", "network", 0, []string{"x: in use"}); !toast.isError || toast.message != "remove failed: x: in use" {
+		t.Errorf("unexpected failure toast %#v", toast)
+	}
+}